@@ -0,0 +1,95 @@
+// Package analytics renders loader snippets for common trackers, gated on a
+// consent decision that travels with the render. Pages place the loaders
+// unconditionally; whether they emit anything is decided once, where the
+// consent cookie or signal is read.
+package analytics
+
+import (
+	"io"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// consentCarrier is implemented by the writer wrapper WithConsent installs.
+type consentCarrier interface {
+	ConsentGranted() bool
+}
+
+type consentWriter struct {
+	io.Writer
+	granted bool
+}
+
+func (cw *consentWriter) ConsentGranted() bool {
+	return cw.granted
+}
+
+func (cw *consentWriter) Unwrap() io.Writer {
+	return cw.Writer
+}
+
+type consented struct {
+	granted  bool
+	children []ElementRenderer
+}
+
+func (c *consented) Render(w io.Writer) error {
+	return Group(c.children...).Render(&consentWriter{Writer: w, granted: c.granted})
+}
+
+// WithConsent makes the consent decision available to the loaders in its
+// subtree. Wrap the page root once with the value read from the consent
+// cookie.
+func WithConsent(granted bool, children ...ElementRenderer) ElementRenderer {
+	return &consented{granted: granted, children: children}
+}
+
+// ConsentOf reports the decision installed by the nearest enclosing
+// WithConsent. Without one, consent defaults to denied.
+func ConsentOf(w io.Writer) bool {
+	if c, ok := WriterAs[consentCarrier](w); ok {
+		return c.ConsentGranted()
+	}
+	return false
+}
+
+// gated renders its loader only when consent was granted.
+type gated struct {
+	loader ElementRenderer
+}
+
+func (g *gated) Render(w io.Writer) error {
+	if !ConsentOf(w) {
+		return nil
+	}
+	return g.loader.Render(w)
+}
+
+// Plausible renders the Plausible loader for the given site domain.
+func Plausible(domain string) ElementRenderer {
+	return &gated{loader: SCRIPT().
+		DEFER().
+		SRC("https://plausible.io/js/script.js").
+		CustomData("domain", domain)}
+}
+
+// Umami renders the umami loader served from src for the given website id.
+func Umami(src, websiteID string) ElementRenderer {
+	return &gated{loader: SCRIPT().
+		DEFER().
+		SRC(src).
+		CustomData("website-id", websiteID)}
+}
+
+// GA4 renders the Google Analytics 4 loader and its inline config for the
+// given measurement id.
+func GA4(measurementID string) ElementRenderer {
+	return &gated{loader: Group(
+		SCRIPT().ASYNC().SRC("https://www.googletagmanager.com/gtag/js?id="+measurementID),
+		SCRIPT(Text(
+			"window.dataLayer = window.dataLayer || [];"+
+				"function gtag(){dataLayer.push(arguments);}"+
+				"gtag('js', new Date());"+
+				"gtag('config', '"+measurementID+"');")),
+	)}
+}
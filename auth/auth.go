@@ -0,0 +1,110 @@
+// Package auth keeps authorization display logic in the tree: wrap the page
+// in WithIdentity once per request, then guard subtrees with RequireRole
+// instead of scattering if-statements before construction. Guards control
+// what renders, not what the server accepts — handlers still authorize.
+package auth
+
+import (
+	"io"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Identity is who the page renders for.
+type Identity struct {
+	Subject string
+	Roles   []string
+}
+
+// HasRole reports whether the identity carries role.
+func (id Identity) HasRole(role string) bool {
+	for _, r := range id.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// identityCarrier is implemented by the writer wrapper WithIdentity installs.
+type identityCarrier interface {
+	RenderIdentity() Identity
+}
+
+type identityWriter struct {
+	io.Writer
+	id Identity
+}
+
+func (iw *identityWriter) RenderIdentity() Identity {
+	return iw.id
+}
+
+func (iw *identityWriter) Unwrap() io.Writer {
+	return iw.Writer
+}
+
+type identified struct {
+	id       Identity
+	children []ElementRenderer
+}
+
+func (i *identified) Render(w io.Writer) error {
+	return Group(i.children...).Render(&identityWriter{Writer: w, id: i.id})
+}
+
+// WithIdentity renders children with id available to the guards in its
+// subtree. Wrap the page root once with the identity resolved from the
+// request; an anonymous visitor is simply the zero Identity.
+func WithIdentity(id Identity, children ...ElementRenderer) ElementRenderer {
+	return &identified{id: id, children: children}
+}
+
+// IdentityOf reports the identity installed by the nearest enclosing
+// WithIdentity; ok is false outside one.
+func IdentityOf(w io.Writer) (Identity, bool) {
+	if c, ok := WriterAs[identityCarrier](w); ok {
+		return c.RenderIdentity(), true
+	}
+	return Identity{}, false
+}
+
+type roleGuard struct {
+	check    func(Identity, bool) bool
+	child    ElementRenderer
+	fallback ElementRenderer
+}
+
+func (g *roleGuard) Render(w io.Writer) error {
+	id, ok := IdentityOf(w)
+	if g.check(id, ok) {
+		if g.child == nil {
+			return nil
+		}
+		return g.child.Render(w)
+	}
+	if g.fallback == nil {
+		return nil
+	}
+	return g.fallback.Render(w)
+}
+
+// RequireRole renders child only when the render identity carries role,
+// otherwise fallback (nil renders nothing).
+func RequireRole(role string, child, fallback ElementRenderer) ElementRenderer {
+	return &roleGuard{
+		check:    func(id Identity, ok bool) bool { return ok && id.HasRole(role) },
+		child:    child,
+		fallback: fallback,
+	}
+}
+
+// RequireAuthenticated renders child for any signed-in identity (non-empty
+// Subject), otherwise fallback.
+func RequireAuthenticated(child, fallback ElementRenderer) ElementRenderer {
+	return &roleGuard{
+		check:    func(id Identity, ok bool) bool { return ok && id.Subject != "" },
+		child:    child,
+		fallback: fallback,
+	}
+}
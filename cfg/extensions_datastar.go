@@ -134,9 +134,9 @@ var DatastarExtensions = []*pb.Attribute{
 			datastarModifiers.ThrottleMs,
 			datastarModifiers.ThrottleMsNoLeading,
 			datastarModifiers.ThrottleMsTrailing,
-			datastarModifiers.ThrotlleSec,
-			datastarModifiers.ThrotlleSecNoLeading,
-			datastarModifiers.ThrotlleSecTrailing,
+			datastarModifiers.ThrottleSec,
+			datastarModifiers.ThrottleSecNoLeading,
+			datastarModifiers.ThrottleSecTrailing,
 			datastarModifiers.ViewTransition,
 			datastarModifiers.Window,
 			datastarModifiers.Prevent,
@@ -163,9 +163,9 @@ var DatastarExtensions = []*pb.Attribute{
 			datastarModifiers.ThrottleMs,
 			datastarModifiers.ThrottleMsNoLeading,
 			datastarModifiers.ThrottleMsTrailing,
-			datastarModifiers.ThrotlleSec,
-			datastarModifiers.ThrotlleSecNoLeading,
-			datastarModifiers.ThrotlleSecTrailing,
+			datastarModifiers.ThrottleSec,
+			datastarModifiers.ThrottleSecNoLeading,
+			datastarModifiers.ThrottleSecTrailing,
 			datastarModifiers.ViewTransition,
 		),
 	},
@@ -203,9 +203,9 @@ var DatastarExtensions = []*pb.Attribute{
 			datastarModifiers.ThrottleMs,
 			datastarModifiers.ThrottleMsNoLeading,
 			datastarModifiers.ThrottleMsTrailing,
-			datastarModifiers.ThrotlleSec,
-			datastarModifiers.ThrotlleSecNoLeading,
-			datastarModifiers.ThrotlleSecTrailing,
+			datastarModifiers.ThrottleSec,
+			datastarModifiers.ThrottleSecNoLeading,
+			datastarModifiers.ThrottleSecTrailing,
 		),
 	},
 	{
@@ -324,9 +324,9 @@ type attributeCustomModifiers struct {
 	ThrottleMs            *pb.Attribute_Custom_Modifier
 	ThrottleMsNoLeading   *pb.Attribute_Custom_Modifier
 	ThrottleMsTrailing    *pb.Attribute_Custom_Modifier
-	ThrotlleSec           *pb.Attribute_Custom_Modifier
-	ThrotlleSecNoLeading  *pb.Attribute_Custom_Modifier
-	ThrotlleSecTrailing   *pb.Attribute_Custom_Modifier
+	ThrottleSec           *pb.Attribute_Custom_Modifier
+	ThrottleSecNoLeading  *pb.Attribute_Custom_Modifier
+	ThrottleSecTrailing   *pb.Attribute_Custom_Modifier
 	ViewTransition        *pb.Attribute_Custom_Modifier
 	Window                *pb.Attribute_Custom_Modifier
 }
@@ -338,8 +338,7 @@ func (a attributeCustomModifiers) validate() attributeCustomModifiers {
 
 	for i := 0; i < value.NumField(); i++ {
 		fieldName := typ.Field(i).Name
-		v := value.Field(i)
-		if v.IsNil() {
+		if value.Field(i).IsNil() {
 			panic("Modifier " + fieldName + " is nil")
 		}
 	}
@@ -525,21 +524,21 @@ var datastarModifiers = attributeCustomModifiers{
 		Prefix:      "throttle.",
 		Suffix:      "ms.trailing",
 	},
-	ThrotlleSec: &pb.Attribute_Custom_Modifier{
+	ThrottleSec: &pb.Attribute_Custom_Modifier{
 		Name:        "ThrottleSec",
 		Description: "Throttles the event listener in seconds.",
-		Type:        AttributeTypeCustomModifier("throtlleSec", false, AttributeTypeDurationSec()),
+		Type:        AttributeTypeCustomModifier("throttleSec", false, AttributeTypeDurationSec()),
 		Prefix:      "throttle.",
 		Suffix:      "s",
 	},
-	ThrotlleSecNoLeading: &pb.Attribute_Custom_Modifier{
+	ThrottleSecNoLeading: &pb.Attribute_Custom_Modifier{
 		Name:        "ThrottleSecNoLeading",
 		Description: "Throttle the event listener in seconds without leading edge.",
 		Type:        AttributeTypeCustomModifier("throttle", false, AttributeTypeDurationSec()),
 		Prefix:      "throttle.",
 		Suffix:      "s.noleading",
 	},
-	ThrotlleSecTrailing: &pb.Attribute_Custom_Modifier{
+	ThrottleSecTrailing: &pb.Attribute_Custom_Modifier{
 		Name:        "ThrottleSecTrailing",
 		Description: "Throttle the event listener in seconds with trailing edge.",
 		Type:        AttributeTypeCustomModifier("throttle", false, AttributeTypeDurationSec()),
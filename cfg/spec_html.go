@@ -14,6 +14,12 @@ var HTML = &pb.Namespace{
 			Type:        AttributeTypeRune(),
 		},
 
+		{
+			Key:         "anchor",
+			Description: `The anchor attribute associates a positioned element with its anchor element by id, for use with CSS anchor positioning. This attribute is experimental.`,
+			Type:        AttributeTypeString(),
+		},
+
 		{
 			Key:         "autocapitalize",
 			Description: `The autocapitalize global attribute is an enumerated attribute that controls whether and how text input is automatically capitalized as it is entered/edited by the user. autocapitalize can be set on <input> and <textarea> elements, and on their containing <form> elements. When autocapitalize is set on a <form> element, it sets the autocapitalize behavior for all contained <input>s and <textarea>s, overriding any autocapitalize values set on contained elements. autocapitalize has no effect on the url, email, or password <input> types, where autocapitalization is never enabled. Where autocapitalize is not specified, the adopted default behavior varies between browsers. For example: Chrome and Safari default to on/sentences Firefox defaults to off/none.`,
@@ -197,6 +203,18 @@ var HTML = &pb.Namespace{
 			),
 		},
 
+		{
+			Key:         "property",
+			Description: `The property RDFa attribute relates the element's content or resource to one or more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.`,
+			Type:        AttributeTypeSpaceDelimited(),
+		},
+
+		{
+			Key:         "resource",
+			Description: `The resource RDFa attribute sets the subject for predicates expressed on the element, as a CURIE or IRI, without navigating to it.`,
+			Type:        AttributeTypeString(),
+		},
+
 		{
 			Key:         "role",
 			Description: `The role global attribute is used to define the purpose or state of an element to the browser, in order to facilitate assistive technology such as screen readers. It is a simple string value that can be used to describe the role of an element.`,
@@ -246,6 +264,18 @@ var HTML = &pb.Namespace{
 				AttributeTypeChoice("no", `indicates that the element must not be translated when the page is localized.`),
 			),
 		},
+
+		{
+			Key:         "typeof",
+			Description: `The typeof RDFa attribute lists the RDF types of the subject, as a space-separated list of terms, CURIEs, or absolute IRIs.`,
+			Type:        AttributeTypeSpaceDelimited(),
+		},
+
+		{
+			Key:         "vocab",
+			Description: `The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms in property and typeof attributes.`,
+			Type:        AttributeTypeString(),
+		},
 	},
 
 	Elements: []*pb.Element{
@@ -718,6 +748,15 @@ var HTML = &pb.Namespace{
 			Tag:         "dialog",
 			Description: "The HTML <dialog> element represents a dialog box or other interactive component, such as an inspector or window. <form> elements can be integrated within a dialog by specifying them with the attribute method=\"dialog\".",
 			Attributes: []*pb.Attribute{
+				{
+					Key:         "closedby",
+					Description: "Specifies which user actions are able to close the dialog.",
+					Type: AttributeTypeChoices(
+						AttributeTypeChoice("any", "The dialog can be dismissed with a light dismiss user action, a platform-specific user action, or a developer-specified mechanism."),
+						AttributeTypeChoice("closerequest", "The dialog can be dismissed with a platform-specific user action or a developer-specified mechanism."),
+						AttributeTypeChoice("none", "The dialog can only be dismissed with a developer-specified mechanism."),
+					),
+				},
 				{
 					Key:         "open",
 					Description: "Indicates whether the dialog is showing.",
@@ -1380,6 +1419,11 @@ var HTML = &pb.Namespace{
 						AttributeTypeChoice("worker", "Indicates that the linked resource is intended to be used as a worker."),
 					),
 				},
+				{
+					Key:         "blocking",
+					Description: "Indicates that certain operations should be blocked on the fetching of the resource. Currently the only operation that can be blocked is rendering.",
+					Type:        AttributeTypeSpaceDelimited(),
+				},
 				{
 					Key:         "crossorigin",
 					Description: "How the element handles crossorigin requests.",
@@ -1796,6 +1840,11 @@ var HTML = &pb.Namespace{
 					Description: "Execute script when available, without blocking.",
 					Type:        AttributeTypeBool(),
 				},
+				{
+					Key:         "blocking",
+					Description: "Indicates that certain operations should be blocked on the fetching of the resource. Currently the only operation that can be blocked is rendering.",
+					Type:        AttributeTypeSpaceDelimited(),
+				},
 				{
 					Key:         "crossorigin",
 					Description: "How the element handles crossorigin requests.",
@@ -1862,6 +1911,11 @@ var HTML = &pb.Namespace{
 			Description: "The HTML <s> element renders text with a strikethrough, or a line through it. Use the <s> element to represent things that are no longer relevant or no longer accurate. However, <s> is not appropriate when indicating document edits; for that, use the <del> and <ins> elements, as appropriate.",
 		},
 
+		{
+			Tag:         "search",
+			Description: "The HTML <search> element is a container representing the parts of the document or application with form controls or other content related to performing a search or filtering operation. The <search> element semantically identifies the purpose of the element's contents as having search or filtering capabilities.",
+		},
+
 		{
 			Tag:         "section",
 			Description: "The HTML <section> element represents a standalone section — which doesn't have a more specific semantic element to represent it — contained within an HTML document. Typically, but not always, sections have a heading.",
@@ -1981,6 +2035,11 @@ var HTML = &pb.Namespace{
 			Tag:         "style",
 			Description: "The HTML <style> element contains style information for a document, or part of a document. By default, the style instructions written inside that element are expected to be CSS.",
 			Attributes: []*pb.Attribute{
+				{
+					Key:         "blocking",
+					Description: "Indicates that certain operations should be blocked on the fetching of the resource. Currently the only operation that can be blocked is rendering.",
+					Type:        AttributeTypeSpaceDelimited(),
+				},
 				{
 					Key:         "media",
 					Description: "Specifies what media/device the media resource is optimized for.",
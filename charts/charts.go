@@ -0,0 +1,159 @@
+// Package charts builds small pure-SVG charts (line, bar, sparkline) from Go
+// data slices, for server-rendered dashboards with no client-side charting
+// dependency.
+package charts
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ChartOption configures a chart.
+type ChartOption func(*chart)
+
+// WithChartTitle sets the accessible title and description rendered as
+// <title>/<desc>, announced instead of the raw geometry.
+func WithChartTitle(title, desc string) ChartOption {
+	return func(c *chart) {
+		c.title = title
+		c.desc = desc
+	}
+}
+
+// WithChartClass adds classes to the chart's root svg element.
+func WithChartClass(classes ...string) ChartOption {
+	return func(c *chart) {
+		c.classes = classes
+	}
+}
+
+type chart struct {
+	title   string
+	desc    string
+	classes []string
+}
+
+// frame builds the shared svg root: size, role, and accessible naming.
+func (c *chart) frame(width, height int, body ...ElementRenderer) ElementRenderer {
+	children := []ElementRenderer{}
+	if c.title != "" {
+		children = append(children, SVG_TITLE(Escaped(c.title)))
+	}
+	if c.desc != "" {
+		children = append(children, SVG_DESC(Escaped(c.desc)))
+	}
+	children = append(children, body...)
+
+	svg := SVG_SVG(children...).
+		WIDTH(fmt.Sprint(width)).
+		HEIGHT(fmt.Sprint(height)).
+		VIEW_BOXF("0 0 %d %d", width, height)
+	if len(c.classes) > 0 {
+		svg.CLASS(c.classes...)
+	}
+	svg.Attr("role", "img")
+	return svg
+}
+
+// bounds returns the value range of a series, widened to a unit range when
+// all values are equal so scaling never divides by zero.
+func bounds(values []float64) (min, max float64) {
+	min, max = values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	if min == max {
+		max = min + 1
+	}
+	return min, max
+}
+
+// scale maps v from [min, max] onto the drawable height, inverted because
+// SVG y grows downward.
+func scale(v, min, max float64, height int) float64 {
+	return float64(height) * (1 - (v-min)/(max-min))
+}
+
+// polylinePoints serializes values as the points attribute of a polyline
+// spanning the full width.
+func polylinePoints(values []float64, width, height int) string {
+	min, max := bounds(values)
+	step := float64(width)
+	if len(values) > 1 {
+		step = float64(width) / float64(len(values)-1)
+	}
+	points := make([]string, len(values))
+	for i, v := range values {
+		points[i] = fmt.Sprintf("%.2f,%.2f", float64(i)*step, scale(v, min, max, height))
+	}
+	return strings.Join(points, " ")
+}
+
+// Line renders values as a line chart of the given pixel size.
+func Line(values []float64, width, height int, opts ...ChartOption) ElementRenderer {
+	c := &chart{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if len(values) == 0 {
+		return c.frame(width, height)
+	}
+	line := SVG_POLYLINE().POINTS(polylinePoints(values, width, height))
+	line.Attr("fill", "none")
+	line.Attr("stroke", "currentColor")
+	return c.frame(width, height, line)
+}
+
+// Sparkline is Line at a small default size for inline use next to text.
+func Sparkline(values []float64, opts ...ChartOption) ElementRenderer {
+	return Line(values, 120, 24, opts...)
+}
+
+// Bar renders values as a bar chart of the given pixel size, bars spaced
+// with a one-pixel gap.
+func Bar(values []float64, width, height int, opts ...ChartOption) ElementRenderer {
+	c := &chart{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if len(values) == 0 {
+		return c.frame(width, height)
+	}
+	min, max := bounds(values)
+	if min > 0 {
+		min = 0
+	}
+	barWidth := float64(width)/float64(len(values)) - 1
+	bars := make([]ElementRenderer, len(values))
+	for i, v := range values {
+		top := scale(v, min, max, height)
+		bar := SVG_RECT().
+			X(float64(i) * float64(width) / float64(len(values))).
+			Y(top).
+			WIDTH(barWidth).
+			HEIGHT(float64(height) - top)
+		bar.Attr("fill", "currentColor")
+		bars[i] = bar
+	}
+	return c.frame(width, height, bars...)
+}
+
+// Axis renders a horizontal or vertical axis line along the chart edge, for
+// composing with Line or Bar inside a shared svg via Group.
+func Axis(width, height int, vertical bool) ElementRenderer {
+	line := SVG_LINE()
+	line.Attr("stroke", "currentColor")
+	if vertical {
+		line.X_1(0).Y_1(0).X_2(0).Y_2(float64(height))
+	} else {
+		line.X_1(0).Y_1(float64(height)).X_2(float64(width)).Y_2(float64(height))
+	}
+	return line
+}
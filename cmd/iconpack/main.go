@@ -0,0 +1,97 @@
+// Command iconpack converts a directory of SVG icon files into typed gostar
+// components, so icons are compile-checked instead of copy-pasted SVG
+// strings.
+//
+// Usage:
+//
+//	go run cmd/iconpack/*.go -in ./lucide/icons -pack Lucide -out ./icons/lucide.go
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+func main() {
+	log.SetFlags(log.Lshortfile | log.LstdFlags)
+
+	in := flag.String("in", "", "directory of .svg files")
+	out := flag.String("out", "", "generated Go file path")
+	pack := flag.String("pack", "", "pack name prefixed to every component (e.g. Lucide)")
+	pkg := flag.String("pkg", "icons", "package name of the generated file")
+	flag.Parse()
+
+	if *in == "" || *out == "" || *pack == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*in, *out, *pack, *pkg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// svgFile is the subset of an SVG document the generator needs: the root
+// viewBox and the inner markup verbatim.
+type svgFile struct {
+	ViewBox string `xml:"viewBox,attr"`
+	Inner   string `xml:",innerxml"`
+}
+
+func run(in, out, pack, pkg string) error {
+	entries, err := os.ReadDir(in)
+	if err != nil {
+		return fmt.Errorf("failed to read icon directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".svg") {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return fmt.Errorf("no .svg files in %s", in)
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "// Code generated by cmd/iconpack from %s. DO NOT EDIT.\n", filepath.Base(in))
+	fmt.Fprintf(sb, "package %s\n\n", pkg)
+	fmt.Fprint(sb, "import (\n\t. \"github.com/delaneyj/gostar/elements\"\n)\n")
+
+	for _, name := range names {
+		raw, err := os.ReadFile(filepath.Join(in, name))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		var svg svgFile
+		if err := xml.Unmarshal(raw, &svg); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		component := pack + strcase.ToCamel(strings.TrimSuffix(name, ".svg"))
+		inner := strings.TrimSpace(svg.Inner)
+		fmt.Fprintf(sb, "\n// %s renders the %q icon from the %s pack.\n",
+			component, strings.TrimSuffix(name, ".svg"), pack)
+		fmt.Fprintf(sb, "func %s(opts ...IconOption) *SVGSVGElement {\n", component)
+		fmt.Fprintf(sb, "\tsvg := SVG_SVG(Text(%q)).VIEW_BOX(%q)\n", inner, svg.ViewBox)
+		fmt.Fprint(sb, "\tsvg.Attr(\"aria-hidden\", \"true\")\n")
+		fmt.Fprint(sb, "\tfor _, opt := range opts {\n\t\topt(svg)\n\t}\n")
+		fmt.Fprint(sb, "\treturn svg\n}\n")
+	}
+
+	if err := os.WriteFile(out, []byte(sb.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", out, err)
+	}
+	log.Printf("generated %d icon components into %s", len(names), out)
+	return nil
+}
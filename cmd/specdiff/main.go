@@ -0,0 +1,51 @@
+// Command specdiff fetches MDN browser-compat data, diffs it against the
+// committed spec protos, and optionally regenerates the elements package, so
+// new platform features land without manual transcription.
+//
+// Usage:
+//
+//	go run cmd/specdiff/*.go [-regen]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/delaneyj/gostar/cfg"
+	"github.com/delaneyj/gostar/generator"
+)
+
+func main() {
+	log.SetFlags(log.Lshortfile | log.LstdFlags)
+
+	regen := flag.Bool("regen", false, "regenerate ./elements after diffing")
+	tmpDir := flag.String("tmp", "./tmp", "cache directory for downloaded spec data")
+	flag.Parse()
+
+	if err := run(context.Background(), *tmpDir, *regen); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, tmpDir string, regen bool) error {
+	diff, err := generator.DiffSpecs(ctx, tmpDir, cfg.Default)
+	if err != nil {
+		return fmt.Errorf("failed to diff specs: %w", err)
+	}
+
+	fmt.Println(diff)
+	if !diff.Empty() {
+		// Non-zero exit so CI can flag spec drift.
+		defer os.Exit(1)
+	}
+
+	if regen {
+		if err := generator.GenerateAll(ctx, "./elements", cfg.Default); err != nil {
+			return fmt.Errorf("failed to generate all: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+)
+
+// AnnouncerID is the id of the Announcer region, which Announce patches.
+const AnnouncerID = "gostar-announcer"
+
+// Politeness selects how a screen reader interrupts for an announcement.
+type Politeness string
+
+const (
+	// Polite waits for the current utterance to finish.
+	Polite Politeness = "polite"
+	// Assertive interrupts immediately; reserve it for urgent updates.
+	Assertive Politeness = "assertive"
+)
+
+// Announcer renders a visually hidden ARIA live region. Render it once per
+// page, then push updates with Announce so dynamic changes reach screen
+// readers.
+func Announcer() ElementRenderer {
+	el := DIV().ID(AnnouncerID).
+		STYLE("position", "absolute").
+		STYLE("width", "1px").
+		STYLE("height", "1px").
+		STYLE("overflow", "hidden").
+		STYLE("clip", "rect(0 0 0 0)").
+		STYLE("white-space", "nowrap")
+	el.Attr("aria-live", string(Polite))
+	el.Attr("aria-atomic", "true")
+	return el
+}
+
+// Announce replaces the live region's content over the given SSE stream so
+// screen readers speak message with the requested politeness.
+func Announce(gen *sse.Generator, message string, politeness Politeness) error {
+	el := DIV().Escaped(message)
+	el.Attr("aria-live", string(politeness))
+	return gen.PatchElements(el,
+		sse.WithSelector("#"+AnnouncerID),
+		sse.WithMode("inner"),
+	)
+}
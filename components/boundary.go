@@ -0,0 +1,115 @@
+package components
+
+import (
+	"fmt"
+	"io"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+// BoundaryOption configures an ErrorBoundary.
+type BoundaryOption func(*boundary)
+
+// WithRetryURL adds a retry button to the default fallback that re-fetches
+// the region via datastar, so a transient failure heals without a full page
+// reload.
+func WithRetryURL(url string) BoundaryOption {
+	return func(b *boundary) {
+		b.retryURL = url
+	}
+}
+
+// WithFallback replaces the default fallback markup. The returned tree is
+// rendered inside the placeholder element that carries the boundary id.
+func WithFallback(fn func(err error) ElementRenderer) BoundaryOption {
+	return func(b *boundary) {
+		b.fallback = fn
+	}
+}
+
+// WithOnError registers a hook called when the boundary trips, with the
+// boundary id and the failure. Wire it to an sse.Generator to queue a patch
+// targeting the placeholder, or to logging.
+func WithOnError(fn func(id string, err error)) BoundaryOption {
+	return func(b *boundary) {
+		b.onError = fn
+	}
+}
+
+type boundary struct {
+	id       string
+	child    ElementRenderer
+	retryURL string
+	fallback func(err error) ElementRenderer
+	onError  func(id string, err error)
+}
+
+// ErrorBoundary wraps child so a render failure inside it degrades to an
+// identifiable placeholder instead of failing the whole page. The placeholder
+// carries the given id, which later SSE patches can target to replace the
+// region once the server recovers.
+func ErrorBoundary(id string, child ElementRenderer, opts ...BoundaryOption) ElementRenderer {
+	b := &boundary{id: id, child: child}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// boundaryBuffer captures the child's bytes so a failure can be discarded,
+// while Unwrap still exposes the destination writer — the render context
+// installed outside the boundary (identity, locale, flags, …) stays visible
+// to lookups inside it.
+type boundaryBuffer struct {
+	buf  *bytebufferpool.ByteBuffer
+	dest io.Writer
+}
+
+func (bb *boundaryBuffer) Write(p []byte) (int, error) {
+	return bb.buf.Write(p)
+}
+
+func (bb *boundaryBuffer) Unwrap() io.Writer {
+	return bb.dest
+}
+
+func (b *boundary) Render(w io.Writer) error {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		if b.child == nil {
+			return nil
+		}
+		return b.child.Render(&boundaryBuffer{buf: buf, dest: w})
+	}()
+	if err == nil {
+		_, werr := w.Write(buf.Bytes())
+		return werr
+	}
+
+	if b.onError != nil {
+		b.onError(b.id, err)
+	}
+	placeholder := DIV().ID(b.id).CLASS("gostar-error-boundary")
+	if b.fallback != nil {
+		placeholder.Children(b.fallback(err))
+	} else {
+		placeholder.Children(
+			P().Text("Something went wrong."),
+			If(b.retryURL != "",
+				BUTTON().
+					TYPE("button").
+					DATASTAR_ON("click", fmt.Sprintf("@get('%s')", b.retryURL)).
+					Text("Retry"),
+			),
+		)
+	}
+	return placeholder.Render(w)
+}
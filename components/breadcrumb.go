@@ -0,0 +1,94 @@
+package components
+
+import (
+	"encoding/json"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Crumb is one step in a breadcrumb trail. The last crumb is the current
+// page; its HREF may be empty.
+type Crumb struct {
+	Label string
+	HREF  string
+}
+
+// BreadcrumbOption configures Breadcrumbs.
+type BreadcrumbOption func(*breadcrumbs)
+
+// WithBreadcrumbJSONLD also emits a schema.org BreadcrumbList as JSON-LD
+// alongside the visible trail, built from the same crumbs so visual and
+// structured data cannot drift apart.
+func WithBreadcrumbJSONLD() BreadcrumbOption {
+	return func(b *breadcrumbs) {
+		b.jsonLD = true
+	}
+}
+
+type breadcrumbs struct {
+	jsonLD bool
+}
+
+// Breadcrumbs renders an accessible breadcrumb trail: a labelled nav
+// landmark with an ordered list, the final crumb marked aria-current.
+func Breadcrumbs(crumbs []Crumb, opts ...BreadcrumbOption) ElementRenderer {
+	b := &breadcrumbs{}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	items := make([]ElementRenderer, 0, len(crumbs))
+	for i, crumb := range crumbs {
+		last := i == len(crumbs)-1
+		if last {
+			// Labels are content-derived (page titles, entity names), so
+			// escape them like the JSON-LD side does.
+			span := SPAN(Escaped(crumb.Label))
+			span.Attr("aria-current", "page")
+			items = append(items, LI(span))
+			continue
+		}
+		items = append(items, LI(A(Escaped(crumb.Label)).HREF(crumb.HREF)))
+	}
+	nav := NAV(OL(items...).CLASS("gostar-breadcrumbs"))
+	nav.Attr("aria-label", "Breadcrumb")
+
+	if !b.jsonLD {
+		return nav
+	}
+	return Group(nav, BreadcrumbJSONLD(crumbs))
+}
+
+// BreadcrumbJSONLD renders the schema.org BreadcrumbList for a trail as a
+// JSON-LD script. Breadcrumbs emits it automatically with
+// WithBreadcrumbJSONLD; use it directly to place the script in the head
+// instead.
+func BreadcrumbJSONLD(crumbs []Crumb) ElementRenderer {
+	type item struct {
+		Type     string `json:"@type"`
+		Position int    `json:"position"`
+		Name     string `json:"name"`
+		Item     string `json:"item,omitempty"`
+	}
+	list := struct {
+		Context string `json:"@context"`
+		Type    string `json:"@type"`
+		Items   []item `json:"itemListElement"`
+	}{
+		Context: "https://schema.org",
+		Type:    "BreadcrumbList",
+	}
+	for i, crumb := range crumbs {
+		list.Items = append(list.Items, item{
+			Type:     "ListItem",
+			Position: i + 1,
+			Name:     crumb.Label,
+			Item:     crumb.HREF,
+		})
+	}
+	payload, err := json.Marshal(list)
+	if err != nil {
+		return Error(err)
+	}
+	return SCRIPT(Text(string(payload))).TYPE("application/ld+json")
+}
@@ -0,0 +1,39 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/expr"
+)
+
+// CopyButton renders a button that copies text to the clipboard. While the
+// $copied signal is set the label switches to "Copied", giving instant
+// feedback without a round trip.
+func CopyButton(text string, children ...ElementRenderer) *BUTTONElement {
+	if len(children) == 0 {
+		children = []ElementRenderer{Text("Copy")}
+	}
+	btn := BUTTON().
+		TYPE("button").
+		DATASTAR_ON("click", expr.CopyToClipboard(text, "copied")).
+		DATASTAR_ATTR("aria-label", "$copied ? 'Copied' : 'Copy to clipboard'")
+	return btn.Children(children...)
+}
+
+// ShareData is what a ShareButton hands to the Web Share API.
+type ShareData struct {
+	Title string
+	URL   string
+}
+
+// ShareButton renders a button invoking the native share sheet where
+// available, falling back to copying the URL (flipping $shared).
+func ShareButton(data ShareData, children ...ElementRenderer) *BUTTONElement {
+	if len(children) == 0 {
+		children = []ElementRenderer{Text("Share")}
+	}
+	btn := BUTTON().
+		TYPE("button").
+		DATASTAR_ON("click", expr.Share(data.Title, data.URL, "shared"))
+	btn.Attr("aria-label", "Share")
+	return btn.Children(children...)
+}
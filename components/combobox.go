@@ -0,0 +1,83 @@
+package components
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/expr"
+)
+
+// ComboboxOption configures a Combobox.
+type ComboboxOption func(*combobox)
+
+// WithComboboxFetchURL fetches suggestions from url as the query changes
+// (debounced); the handler patches the listbox with fresh options.
+func WithComboboxFetchURL(url string) ComboboxOption {
+	return func(c *combobox) {
+		c.fetchURL = url
+	}
+}
+
+type combobox struct {
+	fetchURL string
+}
+
+// Combobox renders an autocomplete input following the ARIA 1.2 combobox
+// pattern: the input carries role=combobox with aria-expanded and
+// aria-activedescendant driven by signals, and the popup is a listbox of
+// options navigable with the arrow keys. name seeds the signals ("city"
+// gives $city, $cityOpen, $cityActive) and the element ids.
+func Combobox(name string, suggestions []string, opts ...ComboboxOption) ElementRenderer {
+	c := &combobox{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	var (
+		openSignal   = name + "Open"
+		activeSignal = name + "Active"
+		listboxID    = name + "-listbox"
+	)
+
+	keydown := fmt.Sprintf(
+		"if (evt.key === 'ArrowDown') { evt.preventDefault(); $%s = true; $%s = Math.min($%s + 1, %d) } "+
+			"else if (evt.key === 'ArrowUp') { evt.preventDefault(); $%s = Math.max($%s - 1, 0) } "+
+			"else if (evt.key === 'Escape') $%s = false",
+		openSignal, activeSignal, activeSignal, len(suggestions)-1,
+		activeSignal, activeSignal,
+		openSignal)
+
+	input := INPUT().
+		ID(name+"-input").
+		NAME(name).
+		TYPE(InputType_text).
+		DATASTAR_BIND(name, "").
+		DATASTAR_ON("focus", fmt.Sprintf("$%s = true", openSignal)).
+		DATASTAR_ON("keydown", keydown).
+		DATASTAR_ATTR("aria-expanded", "$"+openSignal).
+		DATASTAR_ATTR("aria-activedescendant", fmt.Sprintf("'%s-opt-' + $%s", name, activeSignal))
+	input.Attr("role", "combobox")
+	input.Attr("aria-controls", listboxID)
+	input.Attr("aria-autocomplete", "list")
+	if c.fetchURL != "" {
+		input.DATASTAR_ON("input", fmt.Sprintf("@get('%s')", c.fetchURL),
+			InputOnModDebounceMs(300*time.Millisecond))
+	}
+
+	listbox := UL().ID(listboxID).DATASTAR_SHOW("$" + openSignal)
+	listbox.Attr("role", "listbox")
+	for i, suggestion := range suggestions {
+		// Suggestions are data: escape for the JS string literal and then
+		// for the attribute, so neither a ' nor a " breaks out.
+		safe := EscapeF("%s", expr.JSString(suggestion))
+		li := LI(Escaped(suggestion)).
+			ID(fmt.Sprintf("%s-opt-%d", name, i)).
+			DATASTAR_ON("click", fmt.Sprintf("$%s = '%s'; $%s = false", name, safe, openSignal)).
+			DATASTAR_ATTR("aria-selected", fmt.Sprintf("$%s === %d", activeSignal, i))
+		li.Attr("role", "option")
+		listbox.Children(li)
+	}
+
+	return DIV(input, listbox).CLASS("gostar-combobox")
+}
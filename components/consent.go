@@ -0,0 +1,64 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ConsentSignal holds the visitor's cookie choice: "" while undecided, then
+// "accepted" or "rejected". Servers receive it like any other signal.
+const ConsentSignal = "consent"
+
+// ConsentOption configures a ConsentBanner.
+type ConsentOption func(*consentBanner)
+
+// WithConsentLabels replaces the accept/reject button labels, e.g. for
+// localized sites.
+func WithConsentLabels(accept, reject string) ConsentOption {
+	return func(b *consentBanner) {
+		b.acceptLabel = accept
+		b.rejectLabel = reject
+	}
+}
+
+type consentBanner struct {
+	acceptLabel string
+	rejectLabel string
+}
+
+// consentChoiceExpression records a decision, persists it, and reports it to
+// the server so consent-gated content can re-render.
+func consentChoiceExpression(choice, postURL string) string {
+	return fmt.Sprintf("$%s = '%s'; localStorage.setItem('%s', '%s'); @post('%s')",
+		ConsentSignal, choice, ConsentSignal, choice, postURL)
+}
+
+// ConsentBanner renders a cookie consent dialog that hides itself once the
+// visitor decides. The choice persists in localStorage, initializes the
+// consent signal on later visits, and posts to postURL so the server can
+// record it and patch in consent-gated content.
+func ConsentBanner(postURL string, message ElementRenderer, opts ...ConsentOption) ElementRenderer {
+	b := &consentBanner{acceptLabel: "Accept", rejectLabel: "Reject"}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	banner := DIV().
+		ID("gostar-consent").
+		CLASS("gostar-consent").
+		DATASTAR_SIGNALS(ConsentSignal, fmt.Sprintf("localStorage.getItem('%s') || ''", ConsentSignal)).
+		DATASTAR_SHOW(fmt.Sprintf("$%s === ''", ConsentSignal)).
+		Children(
+			message,
+			BUTTON(Text(b.acceptLabel)).
+				TYPE("button").
+				DATASTAR_ON("click", consentChoiceExpression("accepted", postURL)),
+			BUTTON(Text(b.rejectLabel)).
+				TYPE("button").
+				DATASTAR_ON("click", consentChoiceExpression("rejected", postURL)),
+		)
+	banner.Attr("role", "dialog")
+	banner.Attr("aria-label", "Cookie consent")
+	return banner
+}
@@ -0,0 +1,107 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ErrorPageOption configures the stock error pages.
+type ErrorPageOption func(*errorPage)
+
+// WithErrorBrand places a logo or site name above the error heading.
+func WithErrorBrand(brand ElementRenderer) ErrorPageOption {
+	return func(p *errorPage) {
+		p.brand = brand
+	}
+}
+
+// WithErrorHome replaces the destination of the escape-hatch link, "/" by
+// default.
+func WithErrorHome(href, label string) ErrorPageOption {
+	return func(p *errorPage) {
+		p.homeHREF = href
+		p.homeLabel = label
+	}
+}
+
+// WithErrorDetails attaches diagnostic details (stack trace, request id)
+// that render only when dev is true, so production pages never leak
+// internals.
+func WithErrorDetails(details string, dev bool) ErrorPageOption {
+	return func(p *errorPage) {
+		p.details = details
+		p.dev = dev
+	}
+}
+
+type errorPage struct {
+	brand     ElementRenderer
+	homeHREF  string
+	homeLabel string
+	details   string
+	dev       bool
+}
+
+func (p *errorPage) render(heading, message string, extra ...ElementRenderer) ElementRenderer {
+	children := []ElementRenderer{}
+	if p.brand != nil {
+		children = append(children, p.brand)
+	}
+	children = append(children, H1(Text(heading)), P(Text(message)))
+	children = append(children, extra...)
+	if p.homeHREF != "" {
+		children = append(children, P(A(Escaped(p.homeLabel)).HREF(p.homeHREF)))
+	}
+	if p.dev && p.details != "" {
+		// Details come straight from errors and stack traces, which quote
+		// request input; escape so they cannot inject markup.
+		children = append(children,
+			DETAILS(
+				SUMMARY(Text("Details")),
+				PRE(Escaped(p.details)),
+			).CLASS("gostar-error-details"),
+		)
+	}
+	return MAIN(children...).CLASS("gostar-error-page")
+}
+
+func newErrorPage(opts []ErrorPageOption) *errorPage {
+	p := &errorPage{homeHREF: "/", homeLabel: "Go to the homepage"}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// NotFoundPage renders the stock 404 page: a main landmark with a heading,
+// a short explanation and a link home.
+func NotFoundPage(opts ...ErrorPageOption) ElementRenderer {
+	return newErrorPage(opts).render(
+		"Page not found",
+		"The page you are looking for does not exist or has moved.",
+	)
+}
+
+// ServerErrorPage renders the stock 500 page. Pass request-scoped
+// diagnostics via WithErrorDetails; they only render in dev.
+func ServerErrorPage(opts ...ErrorPageOption) ElementRenderer {
+	return newErrorPage(opts).render(
+		"Something went wrong",
+		"An unexpected error occurred. Please try again in a moment.",
+	)
+}
+
+// MaintenancePage renders the stock maintenance page. retryAfter is shown
+// to the reader; pair it with a 503 and a Retry-After header.
+func MaintenancePage(retryAfter string, opts ...ErrorPageOption) ElementRenderer {
+	p := newErrorPage(opts)
+	p.homeHREF = "" // nothing else is up either
+	extra := []ElementRenderer{}
+	if retryAfter != "" {
+		extra = append(extra, P(Escaped("We expect to be back in "+retryAfter+".")))
+	}
+	return p.render(
+		"Down for maintenance",
+		"We are doing planned maintenance and will be back shortly.",
+		extra...,
+	)
+}
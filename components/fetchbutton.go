@@ -0,0 +1,17 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// FetchButton renders a button that runs action on click, flips the given
+// indicator signal while the request is in flight, and disables itself until
+// it settles — the three attributes that together prevent double submission,
+// in one call.
+func FetchButton(indicator, action string, children ...ElementRenderer) *BUTTONElement {
+	return BUTTON(children...).
+		TYPE("button").
+		DATASTAR_INDICATOR(indicator).
+		DATASTAR_ON("click", action).
+		DATASTAR_ATTR("disabled", "$"+indicator)
+}
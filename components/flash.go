@@ -0,0 +1,123 @@
+package components
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+)
+
+// FlashMessage is one queued notification, shown on the next render.
+type FlashMessage struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// FlashStore carries flash messages across the redirect between a mutating
+// request and the page that reports its outcome.
+type FlashStore interface {
+	Push(w http.ResponseWriter, r *http.Request, msg FlashMessage) error
+	Pop(w http.ResponseWriter, r *http.Request) ([]FlashMessage, error)
+}
+
+// CookieFlashStore keeps the queue in a short-lived cookie, so it works
+// without server-side session state. Messages are visible to the client;
+// don't flash secrets.
+type CookieFlashStore struct {
+	// Name overrides the cookie name, "gostar_flash" by default.
+	Name string
+}
+
+func (c *CookieFlashStore) name() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return "gostar_flash"
+}
+
+func (c *CookieFlashStore) Push(w http.ResponseWriter, r *http.Request, msg FlashMessage) error {
+	queue, _ := c.read(r)
+	queue = append(queue, msg)
+	payload, err := json.Marshal(queue)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     c.name(),
+		Value:    base64.URLEncoding.EncodeToString(payload),
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+func (c *CookieFlashStore) Pop(w http.ResponseWriter, r *http.Request) ([]FlashMessage, error) {
+	queue, err := c.read(r)
+	if err != nil || len(queue) == 0 {
+		return nil, err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   c.name(),
+		Value:  "",
+		Path:   "/",
+		MaxAge: -1,
+	})
+	return queue, nil
+}
+
+func (c *CookieFlashStore) read(r *http.Request) ([]FlashMessage, error) {
+	cookie, err := r.Cookie(c.name())
+	if err != nil {
+		return nil, nil
+	}
+	payload, err := base64.URLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+	var queue []FlashMessage
+	if err := json.Unmarshal(payload, &queue); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// Flash queues a message for the next render, typically right before a
+// redirect.
+func Flash(store FlashStore, w http.ResponseWriter, r *http.Request, level, message string) error {
+	return store.Push(w, r, FlashMessage{Level: level, Message: message})
+}
+
+// FlashMessages consumes the queue and renders it as toasts inside the
+// Toasts region; with nothing queued it renders nothing. Rendering consumes:
+// a refresh shows the page clean.
+func FlashMessages(store FlashStore, w http.ResponseWriter, r *http.Request) ElementRenderer {
+	queue, err := store.Pop(w, r)
+	if err != nil || len(queue) == 0 {
+		return Group()
+	}
+	region := Toasts().(BaseElementer).BaseElement()
+	for _, msg := range queue {
+		region.Descendants = append(region.Descendants, Toast(flashLevel(msg.Level), msg.Message))
+	}
+	return region
+}
+
+// flashLevel maps a deserialized level onto the known toast levels. The
+// cookie is client-writable, so anything unexpected downgrades to info
+// instead of reaching the toast's class attribute verbatim.
+func flashLevel(level string) string {
+	switch level {
+	case ToastInfo, ToastSuccess, ToastWarning, ToastError:
+		return level
+	}
+	return ToastInfo
+}
+
+// PushFlash delivers a flash over a live SSE session instead of waiting for
+// the next full render.
+func PushFlash(gen *sse.Generator, level, message string) error {
+	return PushToast(gen, level, message)
+}
@@ -0,0 +1,110 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// GridColumn describes one column of a DataGrid. Key is the server-side sort
+// key sent in the signals; Sortable columns render a header button that flips
+// the sort signals and refetches.
+type GridColumn struct {
+	Key      string
+	Label    string
+	Sortable bool
+}
+
+// GridOption configures a DataGrid.
+type GridOption func(*grid)
+
+// WithGridID replaces the default element id ("gostar-grid").
+func WithGridID(id string) GridOption {
+	return func(g *grid) {
+		g.id = id
+	}
+}
+
+// WithGridPager appends previous/next page buttons below the table.
+func WithGridPager() GridOption {
+	return func(g *grid) {
+		g.pager = true
+	}
+}
+
+type grid struct {
+	id    string
+	pager bool
+}
+
+// DataGrid renders a table whose sorting and paging are driven server-side:
+// clicking a sortable header (or a pager button) updates the
+// $<name>Sort/$<name>Dir/$<name>Page signals and issues @get(fetchURL). The
+// handler reads those signals from the datastar request, queries accordingly,
+// and patches the tbody (id "<grid id>-rows") with fresh rows — rows here are
+// only the initial page, one cell renderer per column.
+func DataGrid(name, fetchURL string, columns []GridColumn, rows [][]ElementRenderer, opts ...GridOption) ElementRenderer {
+	g := &grid{id: "gostar-grid"}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	var (
+		sortSignal = name + "Sort"
+		dirSignal  = name + "Dir"
+		pageSignal = name + "Page"
+		refetch    = fmt.Sprintf("@get('%s')", fetchURL)
+	)
+
+	headerRow := TR()
+	for _, col := range columns {
+		th := TH()
+		if col.Sortable {
+			sort := fmt.Sprintf(
+				"if ($%s === '%s') { $%s = $%s === 'asc' ? 'desc' : 'asc' } "+
+					"else { $%s = '%s'; $%s = 'asc' }; $%s = 0; %s",
+				sortSignal, col.Key, dirSignal, dirSignal,
+				sortSignal, col.Key, dirSignal, pageSignal, refetch)
+			th.DATASTAR_ATTR("aria-sort", fmt.Sprintf(
+				"$%s === '%s' ? ($%s === 'asc' ? 'ascending' : 'descending') : 'none'",
+				sortSignal, col.Key, dirSignal))
+			th.Children(BUTTON(Text(col.Label)).
+				TYPE("button").
+				DATASTAR_ON("click", sort))
+		} else {
+			th.Children(Text(col.Label))
+		}
+		headerRow.Children(th)
+	}
+
+	body := TBODY().ID(g.id + "-rows")
+	for _, row := range rows {
+		tr := TR()
+		for _, cell := range row {
+			tr.Children(TD(cell))
+		}
+		body.Children(tr)
+	}
+
+	table := TABLE(THEAD(headerRow), body)
+
+	root := DIV(table).
+		ID(g.id).
+		CLASS("gostar-grid").
+		DATASTAR_SIGNALS(sortSignal, "''").
+		DATASTAR_SIGNALS(dirSignal, "'asc'").
+		DATASTAR_SIGNALS(pageSignal, "0")
+	if g.pager {
+		root.Children(
+			BUTTON(Text("Previous")).
+				TYPE("button").
+				DATASTAR_ON("click", fmt.Sprintf(
+					"$%s = Math.max($%s - 1, 0); %s", pageSignal, pageSignal, refetch)),
+			BUTTON(Text("Next")).
+				TYPE("button").
+				DATASTAR_ON("click", fmt.Sprintf(
+					"$%s = $%s + 1; %s", pageSignal, pageSignal, refetch)),
+		)
+	}
+	return root
+}
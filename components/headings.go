@@ -0,0 +1,54 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// HeadingContext tracks section nesting depth so reusable components can emit
+// headings relative to wherever they are mounted, instead of hardcoding h2/h3
+// and breaking the document outline when moved.
+type HeadingContext struct {
+	level int
+}
+
+// NewHeadingContext returns a context at level 1, i.e. its H renders an h1.
+func NewHeadingContext() *HeadingContext {
+	return &HeadingContext{level: 1}
+}
+
+// Level returns the heading level this context renders at.
+func (hc *HeadingContext) Level() int {
+	return hc.level
+}
+
+// Section returns a child context one level deeper, to pass into nested
+// components.
+func (hc *HeadingContext) Section() *HeadingContext {
+	return &HeadingContext{level: hc.level + 1}
+}
+
+// H emits a heading at the context's level plus offset, clamped to the h1–h6
+// range.
+func (hc *HeadingContext) H(offset int, children ...ElementRenderer) ElementRenderer {
+	level := hc.level + offset
+	if level < 1 {
+		level = 1
+	}
+	if level > 6 {
+		level = 6
+	}
+	switch level {
+	case 1:
+		return H1(children...)
+	case 2:
+		return H2(children...)
+	case 3:
+		return H3(children...)
+	case 4:
+		return H4(children...)
+	case 5:
+		return H5(children...)
+	default:
+		return H6(children...)
+	}
+}
@@ -0,0 +1,68 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// InfiniteScrollOption configures an InfiniteScroll sentinel.
+type InfiniteScrollOption func(*infiniteScroll)
+
+// WithScrollID sets the sentinel element's id, which the next-page patch
+// replaces to re-arm the trigger. Defaults to "infinite-scroll".
+func WithScrollID(id string) InfiniteScrollOption {
+	return func(s *infiniteScroll) {
+		s.id = id
+	}
+}
+
+// WithScrollIndicator sets the signal name flipped while the next page is in
+// flight. Defaults to "fetching".
+func WithScrollIndicator(signal string) InfiniteScrollOption {
+	return func(s *infiniteScroll) {
+		s.indicator = signal
+	}
+}
+
+// WithScrollLoading replaces the default loading children shown while the
+// indicator signal is true.
+func WithScrollLoading(children ...ElementRenderer) InfiniteScrollOption {
+	return func(s *infiniteScroll) {
+		s.loading = children
+	}
+}
+
+type infiniteScroll struct {
+	nextURL   string
+	id        string
+	indicator string
+	loading   []ElementRenderer
+}
+
+// InfiniteScroll renders the sentinel that drives a feed page: when it
+// scrolls into view it fetches nextURL via datastar and flips an indicator
+// signal while the request is in flight. The handler should patch new items
+// in append mode and replace the sentinel with one pointing at the following
+// page (or nothing when the feed is exhausted).
+func InfiniteScroll(nextURL string, opts ...InfiniteScrollOption) ElementRenderer {
+	s := &infiniteScroll{
+		nextURL:   nextURL,
+		id:        "infinite-scroll",
+		indicator: "fetching",
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	loading := s.loading
+	if loading == nil {
+		loading = []ElementRenderer{P().Text("Loading more…")}
+	}
+	return DIV().
+		ID(s.id).
+		DATASTAR_INDICATOR(s.indicator).
+		DATASTAR_ON_INTERSECT(fmt.Sprintf("@get('%s')", s.nextURL), DivOnIntersectModOnce()).
+		Children(
+			DIV().DATASTAR_SHOW(fmt.Sprintf("$%s", s.indicator)).Children(loading...),
+		)
+}
@@ -0,0 +1,26 @@
+package components
+
+import (
+	"encoding/base64"
+	"log"
+
+	"github.com/delaneyj/gostar/dev"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// inlineImageWarnBytes is the size above which dev mode warns: many email
+// clients clip messages well before 100KB, and large data: URLs bloat every
+// copy of the page.
+const inlineImageWarnBytes = 32 * 1024
+
+// InlineImage renders an IMG with data base64-encoded into its src, so the
+// image travels inside the document — for emails and single-file HTML
+// exports. Dev mode logs a warning when the payload is large enough to risk
+// client-side clipping.
+func InlineImage(mime string, data []byte) *IMGElement {
+	if dev.Enabled() && len(data) > inlineImageWarnBytes {
+		log.Printf("gostar: inline image of %d bytes (%s) exceeds %d bytes; consider serving it by URL",
+			len(data), mime, inlineImageWarnBytes)
+	}
+	return IMG().SRC("data:" + mime + ";base64," + base64.StdEncoding.EncodeToString(data))
+}
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"io"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// slotRenderer marks a named region inside a layout tree. It renders its
+// fallback children when the page supplies no fill for the name.
+type slotRenderer struct {
+	name     string
+	fallback []ElementRenderer
+}
+
+func (s *slotRenderer) Render(w io.Writer) error {
+	return Group(s.fallback...).Render(w)
+}
+
+// Slot marks a named region in a layout. Pages target it with Fill; the
+// optional fallback children render when no fill is provided.
+func Slot(name string, fallback ...ElementRenderer) ElementRenderer {
+	return &slotRenderer{name: name, fallback: fallback}
+}
+
+// SlotFill pairs a slot name with the content a page supplies for it.
+type SlotFill struct {
+	name     string
+	children []ElementRenderer
+}
+
+// Fill supplies content for the named slot of a layout.
+func Fill(name string, children ...ElementRenderer) SlotFill {
+	return SlotFill{name: name, children: children}
+}
+
+// Layout is a reusable page skeleton containing named slots. The build
+// function runs once per With call, so a Layout can be defined at package
+// level and shared across requests safely.
+type Layout struct {
+	build func() ElementRenderer
+}
+
+// NewLayout wraps a function that builds the skeleton tree, typically
+// containing Slot markers for the regions pages are expected to fill.
+func NewLayout(build func() ElementRenderer) *Layout {
+	return &Layout{build: build}
+}
+
+// With builds the skeleton and resolves every slot against the given fills,
+// returning the composed page.
+func (l *Layout) With(fills ...SlotFill) ElementRenderer {
+	byName := map[string][]ElementRenderer{}
+	for _, f := range fills {
+		byName[f.name] = append(byName[f.name], f.children...)
+	}
+	return resolveSlots(l.build(), byName)
+}
+
+func resolveSlots(r ElementRenderer, fills map[string][]ElementRenderer) ElementRenderer {
+	switch v := r.(type) {
+	case *slotRenderer:
+		if children, ok := fills[v.name]; ok {
+			return Group(children...)
+		}
+		return Group(v.fallback...)
+	case *Grouper:
+		for i, c := range v.Children {
+			v.Children[i] = resolveSlots(c, fills)
+		}
+	case BaseElementer:
+		e := v.BaseElement()
+		if e == nil {
+			return r
+		}
+		for i, d := range e.Descendants {
+			e.Descendants[i] = resolveSlots(d, fills)
+		}
+	}
+	return r
+}
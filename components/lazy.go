@@ -0,0 +1,60 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// LazyOption configures a LazyFragment.
+type LazyOption func(*lazyFragment)
+
+// WithLazyPlaceholder replaces the default placeholder children shown until
+// the fragment loads.
+func WithLazyPlaceholder(children ...ElementRenderer) LazyOption {
+	return func(l *lazyFragment) {
+		l.placeholder = children
+	}
+}
+
+// WithLazyID sets the id of the placeholder element, which the server-side
+// patch should target. It defaults to the last path segment of the URL.
+func WithLazyID(id string) LazyOption {
+	return func(l *lazyFragment) {
+		l.id = id
+	}
+}
+
+type lazyFragment struct {
+	url         string
+	id          string
+	placeholder []ElementRenderer
+}
+
+// LazyFragment renders a placeholder that fetches url via datastar the first
+// time it scrolls into view, so below-the-fold sections load on demand. The
+// server responds with a patch targeting the placeholder's id.
+func LazyFragment(url string, opts ...LazyOption) ElementRenderer {
+	l := &lazyFragment{url: url, id: lastPathSegment(url)}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return DIV().
+		ID(l.id).
+		DATASTAR_ON_INTERSECT(fmt.Sprintf("@get('%s')", l.url), DivOnIntersectModOnce()).
+		Children(l.placeholder...)
+}
+
+func lastPathSegment(url string) string {
+	seg := url
+	for i := len(url) - 1; i >= 0; i-- {
+		if url[i] == '/' {
+			seg = url[i+1:]
+			break
+		}
+	}
+	if seg == "" {
+		seg = "fragment"
+	}
+	return seg
+}
@@ -0,0 +1,44 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// OnlineSignal tracks connectivity as maintained by ConnectionStatus; gate
+// other UI on it with data-show or disabled bindings.
+const OnlineSignal = "online"
+
+// OfflineOption configures a ConnectionStatus banner.
+type OfflineOption func(*offline)
+
+// WithOfflineMessage replaces the default banner text.
+func WithOfflineMessage(message string) OfflineOption {
+	return func(o *offline) {
+		o.message = message
+	}
+}
+
+type offline struct {
+	message string
+}
+
+// ConnectionStatus renders the window-level online/offline listeners feeding
+// the OnlineSignal, plus a banner that appears while the connection is down.
+// Place it once per page.
+func ConnectionStatus(opts ...OfflineOption) ElementRenderer {
+	o := &offline{message: "You are offline — changes will sync when the connection returns."}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	banner := DIV(Text(o.message)).
+		CLASS("gostar-offline-banner").
+		DATASTAR_SHOW("!$" + OnlineSignal)
+	banner.Attr("role", "status")
+
+	return DIV(banner).
+		ID("gostar-connection-status").
+		DATASTAR_SIGNALS(OnlineSignal, "true").
+		DATASTAR_ON("online", "$"+OnlineSignal+" = true", DivOnModWindow()).
+		DATASTAR_ON("offline", "$"+OnlineSignal+" = false", DivOnModWindow())
+}
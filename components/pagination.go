@@ -0,0 +1,58 @@
+package components
+
+import (
+	"fmt"
+	"strconv"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// pageURL is the one place pagination URLs are built, shared by the visible
+// links and the head metadata so they cannot drift. Page 1 is the bare base
+// URL, later pages append ?page=N.
+func pageURL(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+	return fmt.Sprintf("%s?page=%d", base, n)
+}
+
+// Pagination renders an accessible pager for pages 1..pages of the
+// resource at base: previous/next links where they exist and a numbered
+// link per page, the current one marked with aria-current. Pair it with
+// PaginationHead so crawlers see the same structure.
+func Pagination(base string, page, pages int) ElementRenderer {
+	items := []ElementRenderer{}
+	if page > 1 {
+		items = append(items, LI(A(Text("Previous")).HREF(pageURL(base, page-1)).REL("prev")))
+	}
+	for n := 1; n <= pages; n++ {
+		link := A(Text(strconv.Itoa(n))).HREF(pageURL(base, n))
+		if n == page {
+			link.Attr("aria-current", "page")
+		}
+		items = append(items, LI(link))
+	}
+	if page < pages {
+		items = append(items, LI(A(Text("Next")).HREF(pageURL(base, page+1)).REL("next")))
+	}
+	nav := NAV(UL(items...).CLASS("gostar-pagination"))
+	nav.Attr("aria-label", "Pagination")
+	return nav
+}
+
+// PaginationHead contributes the SEO metadata matching Pagination to the
+// document head: a canonical link for the current page and rel=prev/next
+// links where neighbouring pages exist.
+func PaginationHead(base string, page, pages int) ElementRenderer {
+	links := []ElementRenderer{
+		LINK().REL("canonical").HREF(pageURL(base, page)),
+	}
+	if page > 1 {
+		links = append(links, LINK().REL("prev").HREF(pageURL(base, page-1)))
+	}
+	if page < pages {
+		links = append(links, LINK().REL("next").HREF(pageURL(base, page+1)))
+	}
+	return Group(links...)
+}
@@ -0,0 +1,97 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/expr"
+)
+
+// Command palette signals.
+const (
+	// PaletteOpenSignal controls the dialog's visibility; flip it from a
+	// Shortcut ("mod+k") or a menu button.
+	PaletteOpenSignal = "paletteOpen"
+	// PaletteQuerySignal holds the current filter text.
+	PaletteQuerySignal = "paletteQuery"
+)
+
+// PaletteCommand is one entry of a CommandPalette: the label shown and the
+// datastar expression run when chosen.
+type PaletteCommand struct {
+	Label  string
+	Action string
+}
+
+// PaletteOption configures a CommandPalette.
+type PaletteOption func(*palette)
+
+// WithPaletteSearchURL switches the palette to server-searchable mode: the
+// query is sent to url as it changes (debounced), and the handler patches the
+// list with matching commands.
+func WithPaletteSearchURL(url string) PaletteOption {
+	return func(p *palette) {
+		p.searchURL = url
+	}
+}
+
+// WithPalettePlaceholder replaces the input placeholder text.
+func WithPalettePlaceholder(placeholder string) PaletteOption {
+	return func(p *palette) {
+		p.placeholder = placeholder
+	}
+}
+
+type palette struct {
+	searchURL   string
+	placeholder string
+}
+
+// CommandPalette renders an app-style command palette: a dialog with a bound
+// search input and a filtered command list. Filtering happens client-side
+// from the query signal, or server-side with WithPaletteSearchURL. Open it by
+// setting the PaletteOpenSignal, e.g. via Shortcut("mod+k", ...).
+func CommandPalette(commands []PaletteCommand, opts ...PaletteOption) ElementRenderer {
+	p := &palette{placeholder: "Type a command…"}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	input := INPUT().
+		TYPE(InputType_search).
+		PLACEHOLDER(p.placeholder).
+		DATASTAR_BIND(PaletteQuerySignal, "")
+	input.Attr("aria-label", "Command palette")
+	if p.searchURL != "" {
+		input.DATASTAR_ON("input", fmt.Sprintf("@get('%s')", p.searchURL),
+			InputOnModDebounceMs(300*time.Millisecond))
+	}
+
+	list := UL().ID("gostar-palette-list")
+	list.Attr("role", "listbox")
+	for _, cmd := range commands {
+		li := LI(
+			BUTTON(Text(cmd.Label)).
+				TYPE("button").
+				DATASTAR_ON("click", cmd.Action+"; $"+PaletteOpenSignal+" = false"),
+		)
+		li.Attr("role", "option")
+		if p.searchURL == "" {
+			// Labels are developer-supplied, but a quote in one must not
+			// break the expression or the attribute.
+			safe := EscapeF("%s", expr.JSString(strings.ToLower(cmd.Label)))
+			li.DATASTAR_SHOW(fmt.Sprintf(
+				"$%s === '' || '%s'.toLowerCase().includes($%s.toLowerCase())",
+				PaletteQuerySignal, safe, PaletteQuerySignal))
+		}
+		list.Children(li)
+	}
+
+	return DIALOG(input, list).
+		ID("gostar-palette").
+		CLASS("gostar-palette").
+		DATASTAR_ATTR("open", "$"+PaletteOpenSignal).
+		DATASTAR_ON("keydown", fmt.Sprintf("if (evt.key === 'Escape') $%s = false", PaletteOpenSignal))
+}
@@ -0,0 +1,65 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// MainContentID is the focus anchor the skip link targets.
+const MainContentID = "main-content"
+
+// ScaffoldOption configures a PageScaffold.
+type ScaffoldOption func(*scaffold)
+
+// WithScaffoldNav adds a labelled navigation landmark before the main
+// content.
+func WithScaffoldNav(label string, children ...ElementRenderer) ScaffoldOption {
+	return func(s *scaffold) {
+		nav := NAV(children...)
+		nav.Attr("aria-label", label)
+		s.nav = nav
+	}
+}
+
+// WithScaffoldAside adds a labelled complementary landmark after the main
+// content.
+func WithScaffoldAside(label string, children ...ElementRenderer) ScaffoldOption {
+	return func(s *scaffold) {
+		aside := ASIDE(children...)
+		aside.Attr("aria-label", label)
+		s.aside = aside
+	}
+}
+
+// WithSkipLabel replaces the skip link text, e.g. for localized shells.
+func WithSkipLabel(label string) ScaffoldOption {
+	return func(s *scaffold) {
+		s.skipLabel = label
+	}
+}
+
+type scaffold struct {
+	skipLabel string
+	nav       ElementRenderer
+	aside     ElementRenderer
+}
+
+// PageScaffold renders an accessible app shell: a skip-to-content link first
+// in tab order, labelled nav/aside landmarks, and a main landmark with a
+// focusable anchor the skip link jumps to.
+func PageScaffold(main []ElementRenderer, opts ...ScaffoldOption) ElementRenderer {
+	s := &scaffold{skipLabel: "Skip to content"}
+	for _, opt := range opts {
+		opt(s)
+	}
+	children := []ElementRenderer{
+		A(Text(s.skipLabel)).HREF("#" + MainContentID).CLASS("gostar-skip-link"),
+	}
+	if s.nav != nil {
+		children = append(children, s.nav)
+	}
+	children = append(children, MAIN(main...).ID(MainContentID).TABINDEX(-1))
+	if s.aside != nil {
+		children = append(children, s.aside)
+	}
+	return Group(children...)
+}
@@ -0,0 +1,17 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/expr"
+)
+
+// Shortcut renders an invisible element listening for the key combination on
+// the window and running action when it matches — app-style shortcuts like
+// opening a command palette with ctrl+k in one call.
+func Shortcut(combo, action string) ElementRenderer {
+	el := DIV().
+		DATASTAR_ON("keydown", expr.Shortcut(combo, action), DivOnModWindow()).
+		STYLE("display", "none")
+	el.Attr("aria-hidden", "true")
+	return el
+}
@@ -0,0 +1,43 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// SortableOption configures a SortableList.
+type SortableOption func(*sortable)
+
+// WithSortableSignal sets the signal holding the index of the item being
+// dragged. Defaults to "dragging".
+func WithSortableSignal(signal string) SortableOption {
+	return func(s *sortable) {
+		s.signal = signal
+	}
+}
+
+type sortable struct {
+	signal string
+}
+
+// SortableList renders items as a drag-and-drop reorderable list. Dragging an
+// item records its index in a signal; dropping posts `from` and `to` indices
+// to reorderURL, whose handler persists the order and patches the list back.
+func SortableList(id, reorderURL string, items []ElementRenderer, opts ...SortableOption) ElementRenderer {
+	s := &sortable{signal: "dragging"}
+	for _, opt := range opts {
+		opt(s)
+	}
+	ul := UL().ID(id)
+	for i, item := range items {
+		ul.Children(
+			LI(item).
+				DRAGGABLE(LiDraggable_true).
+				DATASTAR_ON(EventDragStart, fmt.Sprintf("$%s = %d", s.signal, i)).
+				DATASTAR_ON(EventDragOver, "evt.preventDefault()").
+				DATASTAR_ON(EventDrop, fmt.Sprintf("@post('%s?from=' + $%s + '&to=%d')", reorderURL, s.signal, i)),
+		)
+	}
+	return ul
+}
@@ -0,0 +1,69 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Tab is one entry of a Tabs component. Panel renders inline; when LazyURL
+// is set the panel starts empty and is fetched the first time the tab
+// activates.
+type Tab struct {
+	Label   string
+	Panel   ElementRenderer
+	LazyURL string
+}
+
+// Tabs renders an accessible tab strip (role=tablist/tab/tabpanel with
+// aria-selected and arrow-key wiring) whose selection lives in the
+// $<name>Tab signal. name also seeds the element ids, so multiple tab strips
+// can share a page.
+func Tabs(name string, tabs []Tab) ElementRenderer {
+	selected := name + "Tab"
+
+	keydown := fmt.Sprintf(
+		"if (evt.key === 'ArrowRight') $%s = ($%s + 1) %% %d; "+
+			"else if (evt.key === 'ArrowLeft') $%s = ($%s + %d) %% %d",
+		selected, selected, len(tabs),
+		selected, selected, len(tabs)-1, len(tabs))
+
+	strip := DIV().
+		DATASTAR_SIGNALS(selected, "0").
+		DATASTAR_ON("keydown", keydown)
+	strip.Attr("role", "tablist")
+
+	panels := make([]ElementRenderer, 0, len(tabs))
+	for i, tab := range tabs {
+		tabID := fmt.Sprintf("%s-tab-%d", name, i)
+		panelID := fmt.Sprintf("%s-panel-%d", name, i)
+
+		activate := fmt.Sprintf("$%s = %d", selected, i)
+		if tab.LazyURL != "" {
+			loaded := fmt.Sprintf("%sLoaded%d", name, i)
+			activate += fmt.Sprintf("; if (!$%s) { $%s = true; @get('%s') }",
+				loaded, loaded, tab.LazyURL)
+		}
+
+		btn := BUTTON(Text(tab.Label)).
+			ID(tabID).
+			TYPE("button").
+			DATASTAR_ON("click", activate).
+			DATASTAR_ATTR("aria-selected", fmt.Sprintf("$%s === %d", selected, i))
+		btn.Attr("role", "tab")
+		btn.Attr("aria-controls", panelID)
+		strip.Children(btn)
+
+		panel := DIV().
+			ID(panelID).
+			DATASTAR_SHOW(fmt.Sprintf("$%s === %d", selected, i))
+		panel.Attr("role", "tabpanel")
+		panel.Attr("aria-labelledby", tabID)
+		if tab.Panel != nil {
+			panel.Children(tab.Panel)
+		}
+		panels = append(panels, panel)
+	}
+
+	return Group(append([]ElementRenderer{strip}, panels...)...)
+}
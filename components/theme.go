@@ -0,0 +1,36 @@
+package components
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ThemeSignal is the datastar signal holding the active theme ("light" or
+// "dark"), shared between ThemeToggle and ThemeInit.
+const ThemeSignal = "theme"
+
+// themeToggleExpression flips the signal, persists it, and applies it as the
+// data-theme attribute CSS keys off.
+const themeToggleExpression = "$" + ThemeSignal + " = $" + ThemeSignal + " === 'dark' ? 'light' : 'dark'; " +
+	"localStorage.setItem('" + ThemeSignal + "', $" + ThemeSignal + "); " +
+	"document.documentElement.dataset." + ThemeSignal + " = $" + ThemeSignal
+
+// ThemeToggle renders a button that switches between light and dark themes,
+// persisting the choice to localStorage and exposing it as the theme signal.
+// Pair it with ThemeInit so the stored choice applies before first paint.
+func ThemeToggle(children ...ElementRenderer) ElementRenderer {
+	btn := BUTTON(children...).
+		TYPE("button").
+		DATASTAR_ON("click", themeToggleExpression)
+	btn.Attr("aria-label", "Toggle color theme")
+	return btn
+}
+
+// ThemeInit renders the inline script that applies the persisted theme (or
+// the OS preference) before first paint, avoiding a flash of the wrong
+// scheme. Place it early in head.
+func ThemeInit() ElementRenderer {
+	return SCRIPT(Text(
+		"document.documentElement.dataset." + ThemeSignal + " = " +
+			"localStorage.getItem('" + ThemeSignal + "') || " +
+			"(matchMedia('(prefers-color-scheme: dark)').matches ? 'dark' : 'light');"))
+}
@@ -0,0 +1,79 @@
+package components
+
+import (
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+)
+
+// ToastRegionID is the id of the Toasts region, which toast patches target in
+// append mode.
+const ToastRegionID = "gostar-toasts"
+
+// Toast levels, rendered as a gostar-toast-<level> class for styling.
+const (
+	ToastInfo    = "info"
+	ToastSuccess = "success"
+	ToastWarning = "warning"
+	ToastError   = "error"
+)
+
+// ToastOption configures a single Toast.
+type ToastOption func(*toast)
+
+// WithToastDuration sets how long the toast stays before removing itself.
+// Defaults to 5 seconds; zero disables auto-dismiss.
+func WithToastDuration(d time.Duration) ToastOption {
+	return func(t *toast) {
+		t.duration = d
+	}
+}
+
+type toast struct {
+	level    string
+	message  string
+	duration time.Duration
+}
+
+// Toasts renders the notification region a page needs once, typically near
+// the end of body. Toast patches append into it; it announces politely to
+// assistive technology.
+func Toasts() ElementRenderer {
+	return DIV().
+		ID(ToastRegionID).
+		CLASS("gostar-toasts").
+		Attr("aria-live", "polite")
+}
+
+// Toast renders a single notification that removes itself after its duration
+// elapses. Render it directly for flash-style messages, or push it live with
+// sse.Generator.PushToast.
+func Toast(level, message string, opts ...ToastOption) ElementRenderer {
+	t := &toast{level: level, message: message, duration: 5 * time.Second}
+	for _, opt := range opts {
+		opt(t)
+	}
+	el := DIV().
+		CLASS("gostar-toast", "gostar-toast-"+t.level).
+		Escaped(t.message)
+	el.Attr("role", "status")
+	if t.duration > 0 {
+		if t.duration%time.Second == 0 {
+			el.DATASTAR_ON_INTERVAL("el.remove()", DivOnIntervalModDurationSec(t.duration))
+		} else {
+			el.DATASTAR_ON_INTERVAL("el.remove()", DivOnIntervalModDurationMs(t.duration))
+		}
+	}
+	return el
+}
+
+// PushToast appends a self-dismissing toast to the page's Toasts region over
+// the given SSE stream, giving live-updating apps notifications in one call.
+func PushToast(gen *sse.Generator, level, message string, opts ...ToastOption) error {
+	return gen.PatchElements(
+		Toast(level, message, opts...),
+		sse.WithSelector("#"+ToastRegionID),
+		sse.WithMode("append"),
+	)
+}
@@ -0,0 +1,175 @@
+// Package components contains higher-level building blocks composed from the
+// generated elements package: navigation, layout, and datastar-wired widgets
+// that applications otherwise rebuild by hand.
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+type tocOptions struct {
+	minLevel int
+	maxLevel int
+	navClass string
+}
+
+// TOCOption configures TableOfContents.
+type TOCOption func(*tocOptions)
+
+// TOCLevels restricts which heading levels are collected (inclusive).
+func TOCLevels(min, max int) TOCOption {
+	return func(o *tocOptions) {
+		o.minLevel = min
+		o.maxLevel = max
+	}
+}
+
+// TOCClass sets the class on the generated nav element.
+func TOCClass(class string) TOCOption {
+	return func(o *tocOptions) {
+		o.navClass = class
+	}
+}
+
+type tocEntry struct {
+	level    int
+	id       string
+	text     string
+	children []*tocEntry
+}
+
+// TableOfContents walks root for h1–h6 elements, assigns ids to headings that
+// lack one (slugified from their text, deduplicated), and returns a nested
+// nav/ol tree linking to them. The headings in root are mutated in place so
+// the anchors resolve.
+func TableOfContents(root ElementRenderer, opts ...TOCOption) ElementRenderer {
+	o := &tocOptions{minLevel: 1, maxLevel: 6}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	seen := map[string]int{}
+	entries := []*tocEntry{}
+	Walk(root, func(e *Element) bool {
+		level := headingLevel(string(e.Tag))
+		if level == 0 || level < o.minLevel || level > o.maxLevel {
+			return true
+		}
+
+		text := textOf(e)
+		id := ""
+		if e.StringAttributes != nil {
+			id, _ = e.StringAttributes.Get("id")
+		}
+		if id == "" {
+			id = slugify(text)
+			if n := seen[id]; n > 0 {
+				id = fmt.Sprintf("%s-%d", id, n+1)
+			}
+			seen[slugify(text)]++
+			e.Attr("id", id)
+		}
+
+		entries = append(entries, &tocEntry{level: level, id: id, text: text})
+		return true
+	})
+
+	nested := nestEntries(entries)
+	nav := NAV(renderEntries(nested)).Attr("aria-label", "Table of contents")
+	if o.navClass != "" {
+		nav.CLASS(o.navClass)
+	}
+	return nav
+}
+
+func headingLevel(tag string) int {
+	if len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6' {
+		return int(tag[1] - '0')
+	}
+	return 0
+}
+
+// nestEntries turns the flat, document-ordered heading list into a forest
+// based on relative levels.
+func nestEntries(entries []*tocEntry) []*tocEntry {
+	roots := []*tocEntry{}
+	stack := []*tocEntry{}
+	for _, e := range entries {
+		for len(stack) > 0 && stack[len(stack)-1].level >= e.level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, e)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, e)
+		}
+		stack = append(stack, e)
+	}
+	return roots
+}
+
+func renderEntries(entries []*tocEntry) ElementRenderer {
+	if len(entries) == 0 {
+		return nil
+	}
+	return OL(
+		Range(entries, func(e *tocEntry) ElementRenderer {
+			return LI(
+				A().HREF("#"+e.id).Escaped(e.text),
+				renderEntries(e.children),
+			)
+		}),
+	)
+}
+
+// textOf flattens the text and escaped-text descendants of an element.
+func textOf(e *Element) string {
+	sb := strings.Builder{}
+	var visit func(r ElementRenderer)
+	visit = func(r ElementRenderer) {
+		switch v := r.(type) {
+		case *TextContent:
+			sb.WriteString(string(*v))
+		case *EscapedContent:
+			sb.WriteString(string(*v))
+		case *Grouper:
+			for _, c := range v.Children {
+				visit(c)
+			}
+		case BaseElementer:
+			if be := v.BaseElement(); be != nil {
+				for _, d := range be.Descendants {
+					visit(d)
+				}
+			}
+		}
+	}
+	for _, d := range e.Descendants {
+		visit(d)
+	}
+	return sb.String()
+}
+
+// slugify lowercases text and replaces runs of non-alphanumerics with single
+// hyphens, producing stable fragment identifiers.
+func slugify(text string) string {
+	sb := strings.Builder{}
+	lastHyphen := true
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			sb.WriteRune(r)
+			lastHyphen = false
+		default:
+			if !lastHyphen {
+				sb.WriteRune('-')
+				lastHyphen = true
+			}
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "-")
+}
@@ -0,0 +1,33 @@
+package components
+
+import (
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ViewTransitionDefaults walks root and appends the __viewtransition modifier
+// to every data-on attribute that does not already carry it, so all datastar
+// patches triggered from the tree animate through the View Transitions API
+// without per-handler modifiers.
+func ViewTransitionDefaults(root ElementRenderer) ElementRenderer {
+	Walk(root, func(e *Element) bool {
+		if e.StringAttributes == nil {
+			return true
+		}
+		rekeyed := map[string]string{}
+		for it := e.StringAttributes.Iterator(); it.Valid(); it.Next() {
+			k := it.Key()
+			if !strings.HasPrefix(k, "data-on:") || strings.Contains(k, "__viewtransition") {
+				continue
+			}
+			rekeyed[k] = it.Value()
+		}
+		for k, v := range rekeyed {
+			e.StringAttributes.Del(k)
+			e.StringAttributes.Set(k+"__viewtransition", v)
+		}
+		return true
+	})
+	return root
+}
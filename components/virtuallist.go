@@ -0,0 +1,60 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// VirtualList renders only the current window of a very long list: the rows
+// given plus, while more remain, an invisible sentinel row whose
+// data-on:intersect requests the next window from fetchURL with an offset
+// query parameter. The handler computes the next window with NextWindow,
+// renders it with VirtualRows, and patches it into the list (id "<name>-list")
+// in append mode, so a 50k-row collection never ships in one payload.
+func VirtualList(name, fetchURL string, offset, total int, rows []ElementRenderer) ElementRenderer {
+	list := UL().ID(name + "-list").CLASS("gostar-virtual-list")
+	list.Children(virtualRows(name, fetchURL, offset, total, rows)...)
+	return list
+}
+
+// VirtualRows renders one window of rows plus the follow-up sentinel, for
+// patching into an existing VirtualList from a handler.
+func VirtualRows(name, fetchURL string, offset, total int, rows []ElementRenderer) ElementRenderer {
+	return Group(virtualRows(name, fetchURL, offset, total, rows)...)
+}
+
+func virtualRows(name, fetchURL string, offset, total int, rows []ElementRenderer) []ElementRenderer {
+	out := make([]ElementRenderer, 0, len(rows)+1)
+	for _, row := range rows {
+		out = append(out, LI(row))
+	}
+	next := offset + len(rows)
+	if next < total {
+		sentinel := LI().
+			ID(fmt.Sprintf("%s-sentinel-%d", name, next)).
+			DATASTAR_ON_INTERSECT(
+				fmt.Sprintf("@get('%s?offset=%d')", fetchURL, next),
+				LiOnIntersectModOnce())
+		sentinel.Attr("aria-hidden", "true")
+		out = append(out, sentinel)
+	}
+	return out
+}
+
+// NextWindow clamps an offset/limit pair against the collection size and
+// reports whether the window is non-empty. Handlers call it with the offset
+// parsed from the sentinel's query parameter.
+func NextWindow(offset, limit, total int) (start, end int, ok bool) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || offset >= total {
+		return 0, 0, false
+	}
+	end = offset + limit
+	if end > total {
+		end = total
+	}
+	return offset, end, true
+}
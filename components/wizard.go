@@ -0,0 +1,110 @@
+package components
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// WizardStep is one step of a Wizard. Valid is an optional datastar
+// expression gating the Next button (e.g. "$email !== ”"); empty means the
+// step is always passable.
+type WizardStep struct {
+	Label   string
+	Content ElementRenderer
+	Valid   string
+}
+
+// WizardOption configures a Wizard.
+type WizardOption func(*wizard)
+
+// WithWizardStepURL posts the signals to url each time a step is completed,
+// letting the handler persist partial progress.
+func WithWizardStepURL(url string) WizardOption {
+	return func(w *wizard) {
+		w.stepURL = url
+	}
+}
+
+// WithWizardSubmitURL posts the signals to url when the final step's button
+// is pressed; without it the last step renders no button.
+func WithWizardSubmitURL(url string) WizardOption {
+	return func(w *wizard) {
+		w.submitURL = url
+	}
+}
+
+type wizard struct {
+	stepURL   string
+	submitURL string
+}
+
+// Wizard renders a multi-step form flow: a progress list, one visible step at
+// a time driven by the $<name>Step signal, Back/Next buttons with per-step
+// validation expressions, and optional server round-trips on step completion
+// and final submit. Step content usually holds DATASTAR_BIND inputs so the
+// signals carry the answers.
+func Wizard(name string, steps []WizardStep, opts ...WizardOption) ElementRenderer {
+	w := &wizard{}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	stepSignal := name + "Step"
+
+	progress := OL().CLASS("gostar-wizard-progress")
+	for i, step := range steps {
+		li := LI(Text(step.Label)).
+			DATASTAR_ATTR("aria-current", fmt.Sprintf("$%s === %d ? 'step' : false", stepSignal, i))
+		progress.Children(li)
+	}
+
+	panels := make([]ElementRenderer, 0, len(steps))
+	for i, step := range steps {
+		advance := fmt.Sprintf("$%s = %d", stepSignal, i+1)
+		if w.stepURL != "" {
+			advance += fmt.Sprintf("; @post('%s')", w.stepURL)
+		}
+
+		var buttons []ElementRenderer
+		if i > 0 {
+			buttons = append(buttons, BUTTON(Text("Back")).
+				TYPE("button").
+				DATASTAR_ON("click", fmt.Sprintf("$%s = %d", stepSignal, i-1)))
+		}
+		last := i == len(steps)-1
+		switch {
+		case !last:
+			next := BUTTON(Text("Next")).
+				TYPE("button").
+				DATASTAR_ON("click", advance)
+			if step.Valid != "" {
+				next.DATASTAR_ATTR("disabled", "!("+step.Valid+")")
+			}
+			buttons = append(buttons, next)
+		case w.submitURL != "":
+			submit := BUTTON(Text("Finish")).
+				TYPE("button").
+				DATASTAR_ON("click", fmt.Sprintf("@post('%s')", w.submitURL))
+			if step.Valid != "" {
+				submit.DATASTAR_ATTR("disabled", "!("+step.Valid+")")
+			}
+			buttons = append(buttons, submit)
+		}
+
+		panel := DIV().
+			ID(fmt.Sprintf("%s-step-%d", name, i)).
+			DATASTAR_SHOW(fmt.Sprintf("$%s === %d", stepSignal, i))
+		if step.Content != nil {
+			panel.Children(step.Content)
+		}
+		panel.Children(buttons...)
+		panels = append(panels, panel)
+	}
+
+	root := DIV(progress).
+		ID(name).
+		CLASS("gostar-wizard").
+		DATASTAR_SIGNALS(stepSignal, "0")
+	return root.Children(panels...)
+}
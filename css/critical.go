@@ -0,0 +1,72 @@
+// Package css records which selectors a page actually uses, so a
+// user-provided pruner can inline per-page critical CSS without parsing the
+// rendered HTML again.
+package css
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Usage is the set of tags, classes and ids a tree will emit when rendered.
+type Usage struct {
+	Tags    map[string]struct{}
+	Classes map[string]struct{}
+	IDs     map[string]struct{}
+}
+
+// HasTag reports whether the tree emits the given tag.
+func (u *Usage) HasTag(tag string) bool {
+	_, ok := u.Tags[tag]
+	return ok
+}
+
+// HasClass reports whether the tree emits the given class.
+func (u *Usage) HasClass(class string) bool {
+	_, ok := u.Classes[class]
+	return ok
+}
+
+// HasID reports whether the tree emits the given id.
+func (u *Usage) HasID(id string) bool {
+	_, ok := u.IDs[id]
+	return ok
+}
+
+// Collect walks root and records every tag, class and id it will emit.
+func Collect(root ElementRenderer) *Usage {
+	u := &Usage{
+		Tags:    map[string]struct{}{},
+		Classes: map[string]struct{}{},
+		IDs:     map[string]struct{}{},
+	}
+	Walk(root, func(e *Element) bool {
+		if tag := string(e.Tag); tag != "" {
+			u.Tags[tag] = struct{}{}
+		}
+		if e.StringAttributes != nil {
+			if id, ok := e.StringAttributes.Get("id"); ok && id != "" {
+				u.IDs[id] = struct{}{}
+			}
+		}
+		if e.DelimitedStrings != nil {
+			if classes, ok := e.DelimitedStrings.Get("class"); ok {
+				for it := classes.Values.Iterator(); it.Valid(); it.Next() {
+					u.Classes[it.Key()] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+	return u
+}
+
+// InlineCritical collects the selectors root uses, hands them to prune, and
+// returns a STYLE element carrying the surviving CSS for the page head. An
+// empty prune result renders nothing.
+func InlineCritical(root ElementRenderer, prune func(*Usage) string) ElementRenderer {
+	critical := prune(Collect(root))
+	if critical == "" {
+		return Group()
+	}
+	return STYLE(Text(critical))
+}
@@ -0,0 +1,84 @@
+package dev
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// deprecatedTags are elements the WHATWG spec lists as obsolete. The spec
+// protos carry no deprecation flag — the generator never emits the obsolete
+// elements at all — so this hand-maintained table is the source of truth for
+// trees built with NewElement or parsed from foreign markup.
+var deprecatedTags = map[string]string{
+	"acronym":   "use <abbr> instead",
+	"applet":    "use <embed> or <object> instead",
+	"basefont":  "use CSS instead",
+	"bgsound":   "use <audio> instead",
+	"big":       "use CSS instead",
+	"blink":     "use CSS instead",
+	"center":    "use CSS text-align instead",
+	"dir":       "use <ul> instead",
+	"font":      "use CSS instead",
+	"frame":     "use <iframe> and CSS instead",
+	"frameset":  "use <iframe> and CSS instead",
+	"isindex":   "use form controls instead",
+	"keygen":    "removed from the platform",
+	"marquee":   "use CSS animations instead",
+	"menuitem":  "removed from the platform",
+	"nobr":      "use CSS white-space instead",
+	"noframes":  "removed with frames",
+	"plaintext": "use <pre> or text/plain instead",
+	"spacer":    "use CSS instead",
+	"strike":    "use <s> or <del> instead",
+	"tt":        "use <code>, <kbd>, or CSS instead",
+	"xmp":       "use <pre> with escaped content instead",
+}
+
+// deprecatedAttrs are obsolete presentational attributes, keyed by attribute
+// name.
+var deprecatedAttrs = map[string]string{
+	"align":        "use CSS instead",
+	"alink":        "use CSS instead",
+	"background":   "use CSS instead",
+	"bgcolor":      "use CSS instead",
+	"border":       "use CSS instead",
+	"cellpadding":  "use CSS instead",
+	"cellspacing":  "use CSS instead",
+	"frameborder":  "use CSS instead",
+	"hspace":       "use CSS instead",
+	"link":         "use CSS instead",
+	"marginheight": "use CSS instead",
+	"marginwidth":  "use CSS instead",
+	"scrolling":    "use CSS overflow instead",
+	"text":         "use CSS instead",
+	"valign":       "use CSS vertical-align instead",
+	"vlink":        "use CSS instead",
+	"vspace":       "use CSS instead",
+}
+
+// CheckDeprecated walks root and reports uses of elements and attributes the
+// WHATWG spec has marked obsolete. It returns nil when dev mode is off so it
+// can stay in production render paths.
+func CheckDeprecated(root ElementRenderer) []string {
+	if !Enabled() {
+		return nil
+	}
+
+	warnings := []string{}
+	Walk(root, func(e *Element) bool {
+		tag := string(e.Tag)
+		if hint, ok := deprecatedTags[tag]; ok {
+			warnings = append(warnings, fmt.Sprintf("obsolete element <%s>: %s", tag, hint))
+		}
+		if e.StringAttributes != nil {
+			for it := e.StringAttributes.Iterator(); it.Valid(); it.Next() {
+				if hint, ok := deprecatedAttrs[it.Key()]; ok {
+					warnings = append(warnings, fmt.Sprintf("obsolete attribute %q on <%s>: %s", it.Key(), tag, hint))
+				}
+			}
+		}
+		return true
+	})
+	return warnings
+}
@@ -0,0 +1,150 @@
+package dev
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+// DumpOption configures a Dump.
+type DumpOption func(*dumper)
+
+// WithMaxDepth stops descending below depth (root is depth 0); pruned
+// subtrees print an ellipsis line. The default is unlimited.
+func WithMaxDepth(depth int) DumpOption {
+	return func(d *dumper) {
+		d.maxDepth = depth
+	}
+}
+
+type dumper struct {
+	maxDepth int
+	elements int
+	texts    int
+	attrs    int
+}
+
+// Dump writes a readable tree of root to w — one line per element with its
+// tag and attribute names, indented by depth, text nodes shortened — followed
+// by aggregate counts and the rendered size. It is a debugging and review aid
+// for large component structures, not a serialization format.
+func Dump(w io.Writer, root elements.ElementRenderer, opts ...DumpOption) error {
+	d := &dumper{maxDepth: -1}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if err := d.dump(w, root, 0); err != nil {
+		return err
+	}
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := root.Render(buf); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d elements, %d text nodes, %d attributes, %d bytes rendered\n",
+		d.elements, d.texts, d.attrs, buf.Len())
+	return err
+}
+
+func (d *dumper) dump(w io.Writer, r elements.ElementRenderer, depth int) error {
+	indent := strings.Repeat("  ", depth)
+	switch v := r.(type) {
+	case nil:
+		return nil
+	case *elements.TextContent:
+		d.texts++
+		_, err := fmt.Fprintf(w, "%s%q\n", indent, shorten(string(*v)))
+		return err
+	case *elements.EscapedContent:
+		d.texts++
+		_, err := fmt.Fprintf(w, "%s%q\n", indent, shorten(string(*v)))
+		return err
+	case *elements.Grouper:
+		for _, c := range v.Children {
+			if err := d.dump(w, c, depth); err != nil {
+				return err
+			}
+		}
+		return nil
+	case elements.BaseElementer:
+		e := v.BaseElement()
+		if e == nil {
+			return nil
+		}
+		d.elements++
+		names := attrNames(e)
+		d.attrs += len(names)
+		line := indent + "<" + string(e.Tag)
+		if len(names) > 0 {
+			line += " " + strings.Join(names, " ")
+		}
+		if _, err := fmt.Fprintln(w, line+">"); err != nil {
+			return err
+		}
+		if d.maxDepth >= 0 && depth >= d.maxDepth && len(e.Descendants) > 0 {
+			_, err := fmt.Fprintln(w, indent+"  …")
+			return err
+		}
+		for _, c := range e.Descendants {
+			if err := d.dump(w, c, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		_, err := fmt.Fprintf(w, "%s(%T)\n", indent, r)
+		return err
+	}
+}
+
+func attrNames(e *elements.Element) []string {
+	var names []string
+	if e.StringAttributes != nil {
+		for it := e.StringAttributes.Iterator(); it.Valid(); it.Next() {
+			names = append(names, it.Key())
+		}
+	}
+	if e.DelimitedStrings != nil {
+		for it := e.DelimitedStrings.Iterator(); it.Valid(); it.Next() {
+			names = append(names, it.Key())
+		}
+	}
+	if e.KVStrings != nil {
+		for it := e.KVStrings.Iterator(); it.Valid(); it.Next() {
+			names = append(names, it.Key())
+		}
+	}
+	if e.BoolAttributes != nil {
+		for it := e.BoolAttributes.Iterator(); it.Valid(); it.Next() {
+			names = append(names, it.Key())
+		}
+	}
+	if e.IntAttributes != nil {
+		for it := e.IntAttributes.Iterator(); it.Valid(); it.Next() {
+			names = append(names, it.Key())
+		}
+	}
+	if e.FloatAttributes != nil {
+		for it := e.FloatAttributes.Iterator(); it.Valid(); it.Next() {
+			names = append(names, it.Key())
+		}
+	}
+	if e.CustomDataAttributes != nil {
+		for it := e.CustomDataAttributes.Iterator(); it.Valid(); it.Next() {
+			names = append(names, "data-"+it.Key())
+		}
+	}
+	return names
+}
+
+func shorten(s string) string {
+	const max = 40
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "…"
+}
@@ -0,0 +1,46 @@
+package dev
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// CheckAutofocus walks root and warns when more than one element carries
+// autofocus: browsers only honor the first, so the extras are silent bugs. It
+// returns nil when dev mode is off.
+func CheckAutofocus(root ElementRenderer) []string {
+	if !Enabled() {
+		return nil
+	}
+
+	warnings := []string{}
+	count := 0
+	Walk(root, func(e *Element) bool {
+		if e.BoolAttributes == nil {
+			return true
+		}
+		if on, ok := e.BoolAttributes.Get("autofocus"); !ok || !on {
+			return true
+		}
+		count++
+		if count > 1 {
+			warnings = append(warnings,
+				fmt.Sprintf("multiple autofocus attributes: <%s> is the %d%s; browsers only honor the first",
+					e.Tag, count, ordinal(count)))
+		}
+		return true
+	})
+	return warnings
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
@@ -0,0 +1,33 @@
+package dev
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// CheckHeadingLevels walks root in document order and reports headings that
+// skip levels (e.g. an h3 directly after an h1), which breaks the document
+// outline for assistive technology. It returns nil when dev mode is off so it
+// can stay in production render paths.
+func CheckHeadingLevels(root ElementRenderer) []string {
+	if !Enabled() {
+		return nil
+	}
+
+	warnings := []string{}
+	last := 0
+	Walk(root, func(e *Element) bool {
+		tag := string(e.Tag)
+		if len(tag) != 2 || tag[0] != 'h' || tag[1] < '1' || tag[1] > '6' {
+			return true
+		}
+		level := int(tag[1] - '0')
+		if last > 0 && level > last+1 {
+			warnings = append(warnings, fmt.Sprintf("heading level skipped: h%d follows h%d", level, last))
+		}
+		last = level
+		return true
+	})
+	return warnings
+}
@@ -0,0 +1,143 @@
+package dev
+
+import (
+	"context"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+)
+
+// LiveReloader is a tiny dev subsystem that tells open browser tabs to reload
+// when files change. Mount it as an SSE endpoint, drop LiveReloadScript into
+// the page head, and either call Reload from your build hook or let Watch poll
+// the filesystem.
+type LiveReloader struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewLiveReloader() *LiveReloader {
+	return &LiveReloader{
+		subscribers: map[chan struct{}]struct{}{},
+	}
+}
+
+// Reload notifies every connected browser to reload. Call it after a rebuild.
+func (lr *LiveReloader) Reload() {
+	lr.mu.Lock()
+	defer lr.mu.Unlock()
+	for ch := range lr.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ServeHTTP is the SSE endpoint the LiveReloadScript connects to. Each
+// connection blocks until Reload is called or the client goes away.
+func (lr *LiveReloader) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	gen := sse.New(w)
+
+	ch := make(chan struct{}, 1)
+	lr.mu.Lock()
+	lr.subscribers[ch] = struct{}{}
+	lr.mu.Unlock()
+	defer func() {
+		lr.mu.Lock()
+		delete(lr.subscribers, ch)
+		lr.mu.Unlock()
+	}()
+
+	// Confirm the stream is up so the script can distinguish a dead server
+	// from a quiet one.
+	if err := gen.Send(sse.Event{Type: "connected"}); err != nil {
+		return
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ch:
+			if err := gen.Send(sse.Event{Type: "reload"}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Watch polls the given paths (files or directories, recursively) and calls
+// Reload whenever a modification time changes. It blocks until ctx is
+// cancelled. Polling avoids a platform watcher dependency and is plenty for
+// dev loops.
+func (lr *LiveReloader) Watch(ctx context.Context, interval time.Duration, paths ...string) error {
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+
+	last := watchState(paths)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			current := watchState(paths)
+			if current != last {
+				last = current
+				lr.Reload()
+			}
+		}
+	}
+}
+
+// watchState reduces the tree under paths to a single comparable value: the
+// file count and the newest modification time.
+func watchState(paths []string) [2]int64 {
+	var count, newest int64
+	for _, p := range paths {
+		filepath.WalkDir(p, func(_ string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			count++
+			if mt := info.ModTime().UnixNano(); mt > newest {
+				newest = mt
+			}
+			return nil
+		})
+	}
+	return [2]int64{count, newest}
+}
+
+// LiveReloadScript returns the script element wiring the page to the reload
+// endpoint. It renders nothing when dev mode is off, so it can stay in the
+// shared layout.
+func LiveReloadScript(endpoint string) ElementRenderer {
+	if !Enabled() {
+		return Group()
+	}
+	return SCRIPT().TextF(`(() => {
+	const connect = () => {
+		const es = new EventSource(%q);
+		es.addEventListener("reload", () => location.reload());
+		es.onerror = () => {
+			es.close();
+			setTimeout(connect, 1000);
+		};
+	};
+	connect();
+})();`, endpoint)
+}
@@ -0,0 +1,161 @@
+// Package dev contains development-time helpers for gostar applications.
+// Everything in this package is gated behind Enabled, which is off unless the
+// GOSTAR_DEV environment variable is set, so it is safe to leave the calls in
+// production code paths.
+package dev
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+var enabled = func() bool {
+	switch strings.ToLower(os.Getenv("GOSTAR_DEV")) {
+	case "1", "true", "on", "yes":
+		return true
+	}
+	return false
+}()
+
+// Enabled reports whether dev mode is active. It defaults to the value of the
+// GOSTAR_DEV environment variable at startup and can be overridden with
+// SetEnabled.
+func Enabled() bool {
+	return enabled
+}
+
+// SetEnabled overrides the GOSTAR_DEV environment variable, mostly useful in
+// tests and custom config wiring.
+func SetEnabled(b bool) {
+	enabled = b
+}
+
+// RenderError carries everything the overlay knows how to display. Only Err is
+// required; the rest enrich the overlay when available.
+type RenderError struct {
+	Err error
+	// Stack is the goroutine stack at the point of failure. If nil the
+	// overlay captures the current stack.
+	Stack []byte
+	// ElementPath is the chain of tags from the root to the element whose
+	// Render returned the error, e.g. ["html", "body", "div"].
+	ElementPath []string
+	// Request, when set, adds method/URL/remote info to the overlay.
+	Request *http.Request
+}
+
+// ErrorOverlay renders a full-screen styled overlay describing a render
+// failure, instead of the blank 500 the browser would otherwise show. It is
+// intended for dev mode only; callers should fall back to a plain error
+// response when Enabled is false.
+func ErrorOverlay(re RenderError) ElementRenderer {
+	if re.Stack == nil {
+		re.Stack = debug.Stack()
+	}
+
+	return DIV(
+		STYLE(Text(overlayCSS)),
+		DIV(
+			H1().Text("gostar render error"),
+			P().CLASS("gostar-error-message").Escaped(re.Err.Error()),
+			If(len(re.ElementPath) > 0,
+				P(
+					SPAN().CLASS("gostar-error-label").Text("element path: "),
+					CODE().Escaped(strings.Join(re.ElementPath, " > ")),
+				),
+			),
+			DynIf(re.Request != nil, func() ElementRenderer {
+				return P(
+					SPAN().CLASS("gostar-error-label").Text("request: "),
+					CODE().EscapedF("%s %s", re.Request.Method, re.Request.URL),
+				)
+			}),
+			H2().Text("Stack trace"),
+			PRE().Escaped(string(re.Stack)),
+		).CLASS("gostar-error-panel"),
+	).CLASS("gostar-error-overlay")
+}
+
+// RenderWithOverlay renders root to w, converting any render error or panic
+// into the dev overlay (status 500) when dev mode is enabled. When dev mode is
+// off it writes a plain 500 instead, never leaking error details.
+func RenderWithOverlay(w http.ResponseWriter, r *http.Request, root ElementRenderer) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	err := func() (err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				err = fmt.Errorf("panic during render: %v", rec)
+			}
+		}()
+		return root.Render(buf)
+	}()
+
+	if err == nil {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(buf.Bytes())
+		return
+	}
+
+	if !Enabled() {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	buf.Reset()
+	overlay := ErrorOverlay(RenderError{Err: err, Request: r})
+	if overlayErr := overlay.Render(buf); overlayErr != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write(buf.Bytes())
+}
+
+const overlayCSS = `
+.gostar-error-overlay {
+	position: fixed;
+	inset: 0;
+	z-index: 2147483647;
+	background: rgba(15, 15, 20, 0.95);
+	color: #f8f8f2;
+	font-family: ui-monospace, SFMono-Regular, Menlo, monospace;
+	overflow: auto;
+	padding: 2rem;
+}
+.gostar-error-panel {
+	max-width: 60rem;
+	margin: 0 auto;
+}
+.gostar-error-panel h1 {
+	color: #ff5555;
+	font-size: 1.5rem;
+}
+.gostar-error-panel h2 {
+	font-size: 1.1rem;
+	margin-top: 2rem;
+}
+.gostar-error-message {
+	background: #2d2d35;
+	border-left: 4px solid #ff5555;
+	padding: 1rem;
+	white-space: pre-wrap;
+}
+.gostar-error-label {
+	color: #8be9fd;
+}
+.gostar-error-panel pre {
+	background: #1e1e24;
+	padding: 1rem;
+	overflow-x: auto;
+	line-height: 1.4;
+}
+`
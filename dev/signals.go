@@ -0,0 +1,160 @@
+package dev
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/expr"
+)
+
+// LintSignals walks root collecting the signals declared via data-signals,
+// data-bind and data-computed and returns one warning per $signal that is
+// referenced in a datastar expression but never declared — the typos like
+// $userNmae that otherwise only show up as a blank spot in the browser. Run
+// it from a dev-mode handler or a test over each page root.
+func LintSignals(root elements.ElementRenderer) []string {
+	l := &signalLinter{
+		declared: map[string]bool{},
+		refs:     map[string]string{},
+	}
+	l.walk(root)
+
+	var warnings []string
+	for name, location := range l.refs {
+		if l.declaredRoot(name) {
+			continue
+		}
+		warnings = append(warnings,
+			fmt.Sprintf("$%s referenced in %s but never declared", name, location))
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
+type signalLinter struct {
+	declared map[string]bool
+	// refs maps a referenced signal to the first place it was seen, e.g.
+	// "<button data-on:click>".
+	refs map[string]string
+}
+
+func (l *signalLinter) walk(r elements.ElementRenderer) {
+	switch v := r.(type) {
+	case *elements.Grouper:
+		for _, c := range v.Children {
+			l.walk(c)
+		}
+	case elements.BaseElementer:
+		e := v.BaseElement()
+		if e == nil {
+			return
+		}
+		l.element(e)
+		for _, c := range e.Descendants {
+			l.walk(c)
+		}
+	}
+}
+
+func (l *signalLinter) element(e *elements.Element) {
+	collect := func(key, value string) {
+		switch {
+		case key == "data-signals" || strings.HasPrefix(key, "data-signals__"):
+			// Object form: declarations are the keys, references may
+			// appear in the values.
+			for _, name := range objectKeys(value) {
+				l.declared[name] = true
+			}
+		case strings.HasPrefix(key, "data-signals:"),
+			strings.HasPrefix(key, "data-bind:"),
+			strings.HasPrefix(key, "data-computed:"):
+			name := key[strings.Index(key, ":")+1:]
+			if i := strings.Index(name, "__"); i >= 0 {
+				name = name[:i]
+			}
+			l.declared[camelize(name)] = true
+		case key == "data-bind" || strings.HasPrefix(key, "data-bind-"):
+			// Value form: the signal name is the attribute value.
+			l.declared[camelize(value)] = true
+			return
+		}
+		if !strings.HasPrefix(key, "data-") {
+			return
+		}
+		for _, name := range expr.Signals(value) {
+			if _, seen := l.refs[name]; !seen {
+				l.refs[name] = fmt.Sprintf("<%s %s>", e.Tag, key)
+			}
+		}
+	}
+
+	if e.StringAttributes != nil {
+		for it := e.StringAttributes.Iterator(); it.Valid(); it.Next() {
+			collect(it.Key(), it.Value())
+		}
+	}
+	if e.CustomDataAttributes != nil {
+		for it := e.CustomDataAttributes.Iterator(); it.Valid(); it.Next() {
+			collect("data-"+it.Key(), it.Value())
+		}
+	}
+}
+
+// declaredRoot reports whether name, or the signal it is nested under
+// ("user.name" under "user"), was declared.
+func (l *signalLinter) declaredRoot(name string) bool {
+	if l.declared[name] {
+		return true
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		return l.declared[name[:i]]
+	}
+	return false
+}
+
+// objectKeys extracts the top-level keys of a datastar signals object like
+// "{count: 0, user: {name: ”}}" without a full JS parser.
+func objectKeys(s string) []string {
+	var keys []string
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{', '(', '[':
+			depth++
+		case '}', ')', ']':
+			depth--
+		case ':':
+			if depth != 1 {
+				continue
+			}
+			// Walk back over the identifier preceding the colon.
+			j := i
+			for j > 0 && isSignalRune(rune(s[j-1])) {
+				j--
+			}
+			if j < i {
+				keys = append(keys, strings.Trim(s[j:i], "'\""))
+			}
+		}
+	}
+	return keys
+}
+
+func isSignalRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '\'' || r == '"'
+}
+
+// camelize converts the kebab-case names datastar keys use back to the
+// camelCase form expressions reference, e.g. "my-signal" → "mySignal".
+func camelize(name string) string {
+	parts := strings.Split(name, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
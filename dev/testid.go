@@ -0,0 +1,37 @@
+package dev
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// InjectTestIDs walks root in document order and sets a deterministic
+// data-testid attribute on every element, so Playwright/Cypress selectors
+// stay stable between runs. Elements with an id reuse it as the testid;
+// everything else gets its tag plus a per-tag document-order ordinal
+// (button-0, button-1, …). It is a no-op when dev mode is off, so production
+// renders stay clean.
+func InjectTestIDs(root ElementRenderer) ElementRenderer {
+	if !Enabled() {
+		return root
+	}
+
+	counts := map[string]int{}
+	Walk(root, func(e *Element) bool {
+		tag := string(e.Tag)
+		if tag == "" {
+			return true
+		}
+		testid := fmt.Sprintf("%s-%d", tag, counts[tag])
+		counts[tag]++
+		if e.StringAttributes != nil {
+			if id, ok := e.StringAttributes.Get("id"); ok && id != "" {
+				testid = id
+			}
+		}
+		e.Attr("data-testid", testid)
+		return true
+	})
+	return root
+}
@@ -3,9 +3,15 @@ package elements
 
 import (
 	"fmt"
+	"hash/fnv"
 	"html"
 	"io"
+	"math/rand"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 
 	"github.com/igrmk/treemap/v2"
 	"github.com/valyala/bytebufferpool"
@@ -75,6 +81,448 @@ func (e *Element) AttrsMap(attrs map[string]string) *Element {
 	return e
 }
 
+// HashTree hashes the logical structure of a tree — tags, attributes, text —
+// by streaming it through an FNV-1a hasher rather than serializing it whole.
+// Equal trees hash equal, so the result works as a memoization key or for
+// change detection before broadcasting a patch.
+func HashTree(root ElementRenderer) (uint64, error) {
+	h := fnv.New64a()
+	if err := hashNode(h, root); err != nil {
+		return 0, err
+	}
+	return h.Sum64(), nil
+}
+
+func hashNode(h io.Writer, r ElementRenderer) error {
+	switch v := r.(type) {
+	case nil:
+	case *TextContent:
+		io.WriteString(h, "t\x00")
+		io.WriteString(h, string(*v))
+		io.WriteString(h, "\x00")
+	case *EscapedContent:
+		io.WriteString(h, "e\x00")
+		io.WriteString(h, string(*v))
+		io.WriteString(h, "\x00")
+	case *Grouper:
+		for _, c := range v.Children {
+			if err := hashNode(h, c); err != nil {
+				return err
+			}
+		}
+	case BaseElementer:
+		e := v.BaseElement()
+		if e == nil {
+			return nil
+		}
+		io.WriteString(h, "<\x00")
+		h.Write(e.Tag)
+		io.WriteString(h, "\x00")
+		if err := hashAttrs(h, e); err != nil {
+			return err
+		}
+		for _, d := range e.Descendants {
+			if err := hashNode(h, d); err != nil {
+				return err
+			}
+		}
+		io.WriteString(h, ">\x00")
+	default:
+		// Opaque renderers have no inspectable structure; hash their output.
+		return r.Render(h)
+	}
+	return nil
+}
+
+func hashAttrs(h io.Writer, e *Element) error {
+	if e.IntAttributes != nil {
+		for it := e.IntAttributes.Iterator(); it.Valid(); it.Next() {
+			fmt.Fprintf(h, "%s\x00%d\x00", it.Key(), it.Value())
+		}
+	}
+	if e.FloatAttributes != nil {
+		for it := e.FloatAttributes.Iterator(); it.Valid(); it.Next() {
+			fmt.Fprintf(h, "%s\x00%v\x00", it.Key(), it.Value())
+		}
+	}
+	if e.StringAttributes != nil {
+		for it := e.StringAttributes.Iterator(); it.Valid(); it.Next() {
+			fmt.Fprintf(h, "%s\x00%s\x00", it.Key(), it.Value())
+		}
+	}
+	if e.DelimitedStrings != nil {
+		for it := e.DelimitedStrings.Iterator(); it.Valid(); it.Next() {
+			io.WriteString(h, it.Key())
+			io.WriteString(h, "\x00")
+			if err := it.Value().Render(h); err != nil {
+				return err
+			}
+			io.WriteString(h, "\x00")
+		}
+	}
+	if e.KVStrings != nil {
+		for it := e.KVStrings.Iterator(); it.Valid(); it.Next() {
+			io.WriteString(h, it.Key())
+			io.WriteString(h, "\x00")
+			if err := it.Value().Render(h); err != nil {
+				return err
+			}
+			io.WriteString(h, "\x00")
+		}
+	}
+	if e.BoolAttributes != nil {
+		for it := e.BoolAttributes.Iterator(); it.Valid(); it.Next() {
+			fmt.Fprintf(h, "%s\x00%t\x00", it.Key(), it.Value())
+		}
+	}
+	if e.CustomDataAttributes != nil {
+		for it := e.CustomDataAttributes.Iterator(); it.Valid(); it.Next() {
+			fmt.Fprintf(h, "data-%s\x00%s\x00", it.Key(), it.Value())
+		}
+	}
+	return nil
+}
+
+// Unwrapper is implemented by every writer wrapper the render-context
+// helpers install (Seeded here; Localize, WithIdentity, WithFlags and
+// friends elsewhere). Wrappers stack as subtrees nest, so a lookup must
+// walk past wrappers it does not recognise instead of asserting only the
+// outermost one; WriterAs does that walk. A new wrapper must return the
+// writer it decorates, or it hides every context installed outside it.
+type Unwrapper interface {
+	Unwrap() io.Writer
+}
+
+// WriterAs walks w through Unwrap until it finds a writer implementing T,
+// reporting false when the chain runs out.
+func WriterAs[T any](w io.Writer) (T, bool) {
+	for w != nil {
+		if c, ok := any(w).(T); ok {
+			return c, true
+		}
+		u, ok := w.(Unwrapper)
+		if !ok {
+			break
+		}
+		w = u.Unwrap()
+	}
+	var zero T
+	return zero, false
+}
+
+// randCarrier is implemented by the writer wrapper Seeded installs.
+type randCarrier interface {
+	RenderRand() *rand.Rand
+}
+
+type seededWriter struct {
+	io.Writer
+	rng *rand.Rand
+}
+
+func (sw *seededWriter) RenderRand() *rand.Rand {
+	return sw.rng
+}
+
+func (sw *seededWriter) Unwrap() io.Writer {
+	return sw.Writer
+}
+
+type seeded struct {
+	seed     int64
+	children []ElementRenderer
+}
+
+func (s *seeded) Render(w io.Writer) error {
+	return Group(s.children...).Render(&seededWriter{
+		Writer: w,
+		rng:    rand.New(rand.NewSource(s.seed)),
+	})
+}
+
+// Seeded renders children with a deterministic random source available via
+// RandOf, so components that generate ids, nonces or cache-busting params
+// produce byte-identical output for the same seed. Derive the seed from the
+// page's inputs to enable content-addressed caching.
+func Seeded(seed int64, children ...ElementRenderer) ElementRenderer {
+	return &seeded{seed: seed, children: children}
+}
+
+// RandOf reports the random source installed by the nearest enclosing
+// Seeded. Outside a Seeded subtree it falls back to an unseeded source, so
+// callers behave sensibly either way.
+func RandOf(w io.Writer) *rand.Rand {
+	if c, ok := WriterAs[randCarrier](w); ok {
+		return c.RenderRand()
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// RandomID returns prefix plus eight random hex digits drawn from RandOf —
+// deterministic inside a Seeded subtree.
+func RandomID(w io.Writer, prefix string) string {
+	return fmt.Sprintf("%s%08x", prefix, RandOf(w).Uint32())
+}
+
+// RenderAllOption configures a RenderAll run.
+type RenderAllOption func(*renderAll)
+
+// WithRenderProgress calls fn after each document finishes (err nil on
+// success); fn must be safe to call from multiple goroutines.
+func WithRenderProgress(fn func(name string, err error)) RenderAllOption {
+	return func(r *renderAll) {
+		r.progress = fn
+	}
+}
+
+type renderAll struct {
+	progress func(name string, err error)
+}
+
+// RenderAll renders every document in docs with up to concurrency renders in
+// flight, for SSG and bulk email runs. It returns the rendered bytes and the
+// per-document errors (nil when everything succeeded); one failing page does
+// not stop the rest.
+func RenderAll(docs map[string]ElementRenderer, concurrency int, opts ...RenderAllOption) (map[string][]byte, map[string]error) {
+	r := &renderAll{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu   sync.Mutex
+		out  = make(map[string][]byte, len(docs))
+		errs map[string]error
+		sem  = make(chan struct{}, concurrency)
+		wg   sync.WaitGroup
+	)
+	for name, doc := range docs {
+		wg.Add(1)
+		go func(name string, doc ElementRenderer) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			buf := bytebufferpool.Get()
+			defer bytebufferpool.Put(buf)
+			err := doc.Render(buf)
+
+			mu.Lock()
+			if err != nil {
+				if errs == nil {
+					errs = map[string]error{}
+				}
+				errs[name] = err
+			} else {
+				rendered := make([]byte, buf.Len())
+				copy(rendered, buf.Bytes())
+				out[name] = rendered
+			}
+			mu.Unlock()
+
+			if r.progress != nil {
+				r.progress(name, err)
+			}
+		}(name, doc)
+	}
+	wg.Wait()
+	return out, errs
+}
+
+// OutputFilter wraps the destination writer in the render pipeline, e.g. a
+// tdewolff/minify writer or a custom transformer. The returned WriteCloser
+// sees the rendered bytes as they stream; Close flushes whatever the filter
+// buffered internally.
+type OutputFilter interface {
+	Filter(w io.Writer) io.WriteCloser
+}
+
+// OutputFilterFunc adapts a function to the OutputFilter interface.
+type OutputFilterFunc func(w io.Writer) io.WriteCloser
+
+func (f OutputFilterFunc) Filter(w io.Writer) io.WriteCloser {
+	return f(w)
+}
+
+// RenderFiltered renders root to w through the filters, applied so the first
+// filter sees the raw render output and the last writes to w. The document is
+// never buffered whole; each filter streams. Filters are closed innermost
+// last so flushes propagate outward.
+func RenderFiltered(w io.Writer, root ElementRenderer, filters ...OutputFilter) error {
+	dst := w
+	closers := make([]io.WriteCloser, 0, len(filters))
+	for i := len(filters) - 1; i >= 0; i-- {
+		fw := filters[i].Filter(dst)
+		closers = append(closers, fw)
+		dst = fw
+	}
+	if err := root.Render(dst); err != nil {
+		return err
+	}
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i].Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// preformattedTags are the elements whose text is left untouched by
+// CollapseWhitespace, because whitespace there is meaningful.
+var preformattedTags = map[string]bool{
+	"pre":      true,
+	"textarea": true,
+	"script":   true,
+	"style":    true,
+}
+
+// CollapseWhitespace rewrites the tree's text nodes HTML-minifier style:
+// runs of whitespace collapse to a single space, and leading/trailing
+// whitespace at an element's edges is trimmed, with nodes that become empty
+// dropped. pre, textarea, script and style subtrees are left alone. The tree
+// is modified in place and returned for chaining.
+func CollapseWhitespace(root ElementRenderer) ElementRenderer {
+	Walk(root, func(e *Element) bool {
+		if preformattedTags[string(e.Tag)] {
+			return false
+		}
+		kept := e.Descendants[:0]
+		for _, d := range e.Descendants {
+			switch v := d.(type) {
+			case *TextContent:
+				*v = TextContent(collapseRuns(string(*v)))
+			case *EscapedContent:
+				*v = EscapedContent(collapseRuns(string(*v)))
+			}
+			kept = append(kept, d)
+		}
+		trimTextEdges(kept)
+		out := kept[:0]
+		for _, d := range kept {
+			if textOf(d) == "" && isText(d) {
+				continue
+			}
+			out = append(out, d)
+		}
+		e.Descendants = out
+		return true
+	})
+	return root
+}
+
+func collapseRuns(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteRune(r)
+	}
+	out := b.String()
+	if space && out != "" {
+		out += " "
+	}
+	if strings.HasPrefix(s, " ") || strings.HasPrefix(s, "\n") || strings.HasPrefix(s, "\t") {
+		if out != "" && !strings.HasPrefix(out, " ") {
+			out = " " + out
+		}
+	}
+	return out
+}
+
+func trimTextEdges(ds []ElementRenderer) {
+	if len(ds) == 0 {
+		return
+	}
+	switch v := ds[0].(type) {
+	case *TextContent:
+		*v = TextContent(strings.TrimLeft(string(*v), " "))
+	case *EscapedContent:
+		*v = EscapedContent(strings.TrimLeft(string(*v), " "))
+	}
+	switch v := ds[len(ds)-1].(type) {
+	case *TextContent:
+		*v = TextContent(strings.TrimRight(string(*v), " "))
+	case *EscapedContent:
+		*v = EscapedContent(strings.TrimRight(string(*v), " "))
+	}
+}
+
+func isText(d ElementRenderer) bool {
+	switch d.(type) {
+	case *TextContent, *EscapedContent:
+		return true
+	}
+	return false
+}
+
+func textOf(d ElementRenderer) string {
+	switch v := d.(type) {
+	case *TextContent:
+		return string(*v)
+	case *EscapedContent:
+		return string(*v)
+	}
+	return ""
+}
+
+// PrependChildren inserts children before the element's existing descendants.
+func (e *Element) PrependChildren(children ...ElementRenderer) *Element {
+	e.Descendants = append(children, e.Descendants...)
+	return e
+}
+
+// InsertChildAt inserts children at index i among the element's descendants,
+// clamping i into range, so layout post-processing can slot a node between
+// existing ones without rebuilding the tree.
+func (e *Element) InsertChildAt(i int, children ...ElementRenderer) *Element {
+	if i < 0 {
+		i = 0
+	}
+	if i > len(e.Descendants) {
+		i = len(e.Descendants)
+	}
+	e.Descendants = append(e.Descendants[:i:i], append(children, e.Descendants[i:]...)...)
+	return e
+}
+
+// ReplaceChild swaps every direct descendant identical to old for new;
+// untouched if old is not a direct child.
+func (e *Element) ReplaceChild(old, new ElementRenderer) *Element {
+	for i, d := range e.Descendants {
+		if d == old {
+			e.Descendants[i] = new
+		}
+	}
+	return e
+}
+
+// ResetAttributes clears every attribute on the element, leaving the tag and
+// descendants intact.
+func (e *Element) ResetAttributes() *Element {
+	e.IntAttributes = nil
+	e.FloatAttributes = nil
+	e.StringAttributes = nil
+	e.DelimitedStrings = nil
+	e.KVStrings = nil
+	e.BoolAttributes = nil
+	e.CustomDataAttributes = nil
+	return e
+}
+
+// Render writes the element and its descendants as HTML. Attributes always
+// render in alphabetical order regardless of the order they were set, so
+// committed snapshots and CDN-cached variants diff minimally between builds.
 func (e *Element) Render(w io.Writer) error {
 	w.Write(openBracket)
 	w.Write(e.Tag)
@@ -179,6 +627,195 @@ func (e *Element) Render(w io.Writer) error {
 	return nil
 }
 
+// RenderString renders the element and its descendants to a string, pooling
+// the intermediate buffer. Handy for logging and for embedding a fragment in
+// a non-gostar template without wiring up bytebufferpool by hand.
+func (e *Element) RenderString() (string, error) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := e.Render(buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// String implements fmt.Stringer so elements drop straight into %s verbs and
+// log calls. A render error surfaces inline as an HTML comment rather than
+// being silently dropped.
+func (e *Element) String() string {
+	s, err := e.RenderString()
+	if err != nil {
+		return fmt.Sprintf("<!-- render error: %v -->", err)
+	}
+	return s
+}
+
+// WriteTo implements io.WriterTo: the element renders into a pooled buffer
+// and is written out in a single call, with the byte count buffered writers
+// and copy helpers expect. Render errors are returned before anything is
+// written.
+func (e *Element) WriteTo(w io.Writer) (int64, error) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := e.Render(buf); err != nil {
+		return 0, err
+	}
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// flusher is the flushable half of http.Flusher, kept local so the builder
+// does not depend on net/http.
+type flusher interface {
+	Flush()
+}
+
+type flushMarker struct{}
+
+func (f *flushMarker) Render(w io.Writer) error {
+	if fl, ok := WriterAs[flusher](w); ok {
+		fl.Flush()
+	}
+	return nil
+}
+
+// Flush returns a marker node that flushes the response writer when
+// rendered. Place one after the closing head or above-the-fold content so
+// the browser starts painting before the rest of the tree renders; on
+// writers that cannot flush it renders nothing.
+func Flush() ElementRenderer {
+	return &flushMarker{}
+}
+
+// flushWriter flushes the underlying writer once threshold bytes have
+// accumulated since the last flush. Explicit Flush markers reset the count.
+type flushWriter struct {
+	io.Writer
+	flusher   flusher
+	threshold int
+	since     int
+}
+
+func (fw *flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.Writer.Write(p)
+	fw.since += n
+	if fw.since >= fw.threshold {
+		fw.Flush()
+	}
+	return n, err
+}
+
+func (fw *flushWriter) Flush() {
+	fw.flusher.Flush()
+	fw.since = 0
+}
+
+func (fw *flushWriter) Unwrap() io.Writer {
+	return fw.Writer
+}
+
+// RenderStreamed renders root to w, flushing whenever threshold bytes have
+// been written since the last flush — Flush markers in the tree still apply
+// and reset the byte count. When w cannot flush this is a plain Render.
+func RenderStreamed(w io.Writer, root ElementRenderer, threshold int) error {
+	fl, ok := WriterAs[flusher](w)
+	if !ok || threshold <= 0 {
+		return root.Render(w)
+	}
+	fw := &flushWriter{Writer: w, flusher: fl, threshold: threshold}
+	if err := root.Render(fw); err != nil {
+		return err
+	}
+	if fw.since > 0 {
+		fw.Flush()
+	}
+	return nil
+}
+
+// DocumentBuilder assembles a complete HTML document: the doctype, the
+// html/head/body skeleton and the head boilerplate every page repeats.
+// Build one with Document and chain the helpers; rendering emits
+// `<!DOCTYPE html>` followed by the tree.
+type DocumentBuilder struct {
+	lang     string
+	charset  string
+	viewport string
+	title    string
+	head     []ElementRenderer
+	body     []ElementRenderer
+}
+
+// Document starts a document with the defaults almost every page wants:
+// UTF-8 and a mobile-friendly viewport. Override them with Charset and
+// Viewport, or clear them by passing "".
+func Document() *DocumentBuilder {
+	return &DocumentBuilder{
+		charset:  "utf-8",
+		viewport: "width=device-width, initial-scale=1",
+	}
+}
+
+// Lang sets the lang attribute on the html element.
+func (d *DocumentBuilder) Lang(lang string) *DocumentBuilder {
+	d.lang = lang
+	return d
+}
+
+// Charset replaces the charset meta value; "" drops the tag.
+func (d *DocumentBuilder) Charset(charset string) *DocumentBuilder {
+	d.charset = charset
+	return d
+}
+
+// Viewport replaces the viewport meta value; "" drops the tag.
+func (d *DocumentBuilder) Viewport(content string) *DocumentBuilder {
+	d.viewport = content
+	return d
+}
+
+// Title sets the document title.
+func (d *DocumentBuilder) Title(title string) *DocumentBuilder {
+	d.title = title
+	return d
+}
+
+// Head appends children to the head, after the charset/viewport/title
+// boilerplate.
+func (d *DocumentBuilder) Head(children ...ElementRenderer) *DocumentBuilder {
+	d.head = append(d.head, children...)
+	return d
+}
+
+// Body appends children to the body.
+func (d *DocumentBuilder) Body(children ...ElementRenderer) *DocumentBuilder {
+	d.body = append(d.body, children...)
+	return d
+}
+
+func (d *DocumentBuilder) Render(w io.Writer) error {
+	if _, err := io.WriteString(w, "<!DOCTYPE html>"); err != nil {
+		return err
+	}
+
+	head := []ElementRenderer{}
+	if d.charset != "" {
+		head = append(head, META().CHARSET(d.charset))
+	}
+	if d.viewport != "" {
+		head = append(head, META().NAME("viewport").CONTENT(d.viewport))
+	}
+	if d.title != "" {
+		head = append(head, TITLE(Escaped(d.title)))
+	}
+	head = append(head, d.head...)
+
+	html := HTML(HEAD(head...), BODY(d.body...))
+	if d.lang != "" {
+		html.LANG(d.lang)
+	}
+	return html.Render(w)
+}
+
 type customDataKeyModifier func() string
 
 func customDataKey(key string, modifiers ...customDataKeyModifier) string {
@@ -286,10 +923,14 @@ func (tc *TextContent) Render(w io.Writer) error {
 	return err
 }
 
+// Text is a standalone text node writing text verbatim, without HTML
+// escaping — fine for literals, wrong for anything user-supplied. For
+// untrusted content use Escaped, which fits the same child positions.
 func Text(text string) *TextContent {
 	return (*TextContent)(&text)
 }
 
+// TextF is Text with fmt.Sprintf formatting; the result is not escaped.
 func TextF(format string, args ...interface{}) *TextContent {
 	return Text(fmt.Sprintf(format, args...))
 }
@@ -301,14 +942,44 @@ func (ec *EscapedContent) Render(w io.Writer) error {
 	return err
 }
 
+// Escaped is the standalone HTML-escaping text node: it renders text with
+// &, <, >, " and ' escaped and is accepted anywhere a child is, so
+// untrusted content never needs a per-element TEXT method.
 func Escaped(text string) *EscapedContent {
 	return (*EscapedContent)(&text)
 }
 
+// EscapedF is Escaped with fmt.Sprintf formatting; the formatted result is
+// escaped as a whole.
 func EscapedF(format string, args ...interface{}) *EscapedContent {
 	return Escaped(fmt.Sprintf(format, args...))
 }
 
+type CommentContent string
+
+func (cc *CommentContent) Render(w io.Writer) error {
+	// Break any "-->" inside the text so it cannot terminate the comment
+	// early; browsers do not parse entities inside comments, so the
+	// replacement is only visible in source.
+	safe := strings.ReplaceAll(string(*cc), "-->", "--&gt;")
+	_, err := fmt.Fprintf(w, "<!--%s-->", safe)
+	return err
+}
+
+// Comment renders an HTML comment node — conditional comments, build
+// markers, debugging notes — usable anywhere a child is accepted.
+func Comment(text string) *CommentContent {
+	return (*CommentContent)(&text)
+}
+
+// UnsafeRaw embeds pre-rendered HTML verbatim, with no escaping and no
+// validation. The name is the warning: only hand it markup from a trusted
+// source such as a sanitized CMS or another renderer — user input here is
+// an XSS hole. For user-facing text use Escaped.
+func UnsafeRaw(html string) ElementRenderer {
+	return Text(html)
+}
+
 type Grouper struct {
 	Children []ElementRenderer
 }
@@ -402,3 +1073,161 @@ func NewElement(tag string, children ...ElementRenderer) *Element {
 func Error(err error) ElementRenderer {
 	return Text(err.Error())
 }
+
+// Style sets one CSS declaration, mirroring the generated STYLE setters for
+// code that only holds a *Element.
+func (e *Element) Style(k, v string) *Element {
+	if e.KVStrings == nil {
+		e.KVStrings = treemap.New[string, *KVBuilder]()
+	}
+	kv, ok := e.KVStrings.Get("style")
+	if !ok {
+		kv = NewKVBuilder(":", ";")
+		e.KVStrings.Set("style", kv)
+	}
+	kv.Add(k, v)
+	return e
+}
+
+// TransitionName tags the element with a view-transition-name, pairing it
+// with its counterpart in the next DOM state during a view transition.
+func (e *Element) TransitionName(name string) *Element {
+	return e.Style("view-transition-name", name)
+}
+
+// Common tabindex values for the TABINDEX setters. Positive values create a
+// custom tab order and are almost always a mistake, so only the two sane
+// choices get names.
+const (
+	// TabIndexFocusable puts the element in the natural tab order.
+	TabIndexFocusable = 0
+	// TabIndexSkip removes the element from the tab order while keeping it
+	// focusable programmatically, e.g. skip-link targets.
+	TabIndexSkip = -1
+)
+
+// Drag-and-drop event names, for use as the key of the DATASTAR_ON setters so
+// handlers do not rely on stringly-typed event names.
+const (
+	EventDrag      = "drag"
+	EventDragEnd   = "dragend"
+	EventDragEnter = "dragenter"
+	EventDragLeave = "dragleave"
+	EventDragOver  = "dragover"
+	EventDragStart = "dragstart"
+	EventDrop      = "drop"
+)
+
+// FrozenRenderer is an immutable, pre-rendered fragment. Element builders are
+// mutable and therefore unsafe to share between goroutines; a FrozenRenderer
+// holds only the rendered bytes and may be reused across requests freely.
+type FrozenRenderer struct {
+	rendered []byte
+}
+
+func (f *FrozenRenderer) Render(w io.Writer) error {
+	_, err := w.Write(f.rendered)
+	return err
+}
+
+// Freeze renders root once and returns an immutable renderer for the result,
+// for prebuilt fragments (navs, footers, icons) shared across requests.
+func Freeze(root ElementRenderer) (*FrozenRenderer, error) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := root.Render(buf); err != nil {
+		return nil, fmt.Errorf("failed to freeze element: %w", err)
+	}
+	rendered := make([]byte, buf.Len())
+	copy(rendered, buf.Bytes())
+	return &FrozenRenderer{rendered: rendered}, nil
+}
+
+// MustFreeze is Freeze for package-level variables; it panics on render
+// failure.
+func MustFreeze(root ElementRenderer) *FrozenRenderer {
+	f, err := Freeze(root)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// EscapeF formats according to format, HTML-escaping each argument first so
+// untrusted values are safe in attribute and text contexts. It prevents the
+// common Sprintf-then-set injection pattern; use it to feed any generated
+// setter, e.g. TITLE(EscapeF("hello %s", userName)).
+func EscapeF(format string, args ...any) string {
+	return fmt.Sprintf(format, escapeArgs(html.EscapeString, args)...)
+}
+
+// EscapeURLF is EscapeF for URL-valued attributes (href, src, action): string
+// arguments are path-escaped instead of HTML-escaped, e.g.
+// HREF(EscapeURLF("/users/%d/orders/%s", id, slug)).
+func EscapeURLF(format string, args ...any) string {
+	return fmt.Sprintf(format, escapeArgs(url.PathEscape, args)...)
+}
+
+func escapeArgs(escape func(string) string, args []any) []any {
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			escaped[i] = escape(v)
+		case fmt.Stringer:
+			escaped[i] = escape(v.String())
+		default:
+			escaped[i] = arg
+		}
+	}
+	return escaped
+}
+
+// AttrF sets the attribute to a formatted value, HTML-escaping each argument
+// via EscapeF.
+func (e *Element) AttrF(name, format string, args ...any) *Element {
+	return e.Attr(name, EscapeF(format, args...))
+}
+
+// AttrURLF sets a URL-valued attribute to a formatted value, path-escaping
+// each string argument via EscapeURLF.
+func (e *Element) AttrURLF(name, format string, args ...any) *Element {
+	return e.Attr(name, EscapeURLF(format, args...))
+}
+
+// BaseElementer is implemented by every generated element type via its
+// embedded Element, giving tree-walking utilities access to tags, attributes,
+// and descendants regardless of the concrete type.
+type BaseElementer interface {
+	BaseElement() *Element
+}
+
+// BaseElement returns the underlying Element.
+func (e *Element) BaseElement() *Element {
+	return e
+}
+
+// Walk traverses the tree rooted at root in document order, calling fn for
+// each element encountered. Returning false skips that element's
+// descendants. Renderers that are not elements (text nodes, groups) are
+// traversed transparently.
+func Walk(root ElementRenderer, fn func(e *Element) bool) {
+	switch v := root.(type) {
+	case nil:
+	case *Grouper:
+		for _, c := range v.Children {
+			Walk(c, fn)
+		}
+	case BaseElementer:
+		e := v.BaseElement()
+		if e == nil {
+			return
+		}
+		if !fn(e) {
+			return
+		}
+		for _, d := range e.Descendants {
+			Walk(d, fn)
+		}
+	}
+}
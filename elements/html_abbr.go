@@ -250,6 +250,63 @@ func (e *ABBRElement) ACCESSKEYRemove() *ABBRElement {
 	return e
 }
 
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ABBRElement) ANCHOR(s string) *ABBRElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ABBRElement) ANCHORF(format string, args ...any) *ABBRElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ABBRElement) IfANCHOR(condition bool, s string) *ABBRElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ABBRElement) IfANCHORF(condition bool, format string, args ...any) *ABBRElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *ABBRElement) ANCHORRemove(s string) *ABBRElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ABBRElement) ANCHORRemoveF(format string, args ...any) *ABBRElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether and how text input is automatically capitalized as it is entered/edited
 // by the user
@@ -1823,6 +1880,96 @@ func (e *ABBRElement) POPOVERRemove(c AbbrPopoverChoice) *ABBRElement {
 	return e
 }
 
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ABBRElement) PROPERTY(s ...string) *ABBRElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ABBRElement) IfPROPERTY(condition bool, s ...string) *ABBRElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *ABBRElement) PROPERTYRemove(s ...string) *ABBRElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ABBRElement) RESOURCE(s string) *ABBRElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ABBRElement) RESOURCEF(format string, args ...any) *ABBRElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ABBRElement) IfRESOURCE(condition bool, s string) *ABBRElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ABBRElement) IfRESOURCEF(condition bool, format string, args ...any) *ABBRElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *ABBRElement) RESOURCERemove(s string) *ABBRElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ABBRElement) RESOURCERemoveF(format string, args ...any) *ABBRElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
 // The role global attribute is used to define the purpose or state of an element
 // to the browser, in order to facilitate assistive technology such as screen
 // readers
@@ -2227,6 +2374,96 @@ func (e *ABBRElement) TRANSLATERemove(c AbbrTranslateChoice) *ABBRElement {
 	return e
 }
 
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ABBRElement) TYPEOF(s ...string) *ABBRElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ABBRElement) IfTYPEOF(condition bool, s ...string) *ABBRElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *ABBRElement) TYPEOFRemove(s ...string) *ABBRElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ABBRElement) VOCAB(s string) *ABBRElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ABBRElement) VOCABF(format string, args ...any) *ABBRElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ABBRElement) IfVOCAB(condition bool, s string) *ABBRElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ABBRElement) IfVOCABF(condition bool, format string, args ...any) *ABBRElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *ABBRElement) VOCABRemove(s string) *ABBRElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ABBRElement) VOCABRemoveF(format string, args ...any) *ABBRElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
 // Sets the value of any HTML attribute to an expression, and keeps it in sync.
 //
 // See: https://data-star.dev/reference/attributes#data-attr
@@ -198,6 +198,63 @@ func (e *ADDRESSElement) ACCESSKEYRemove() *ADDRESSElement {
 	return e
 }
 
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ADDRESSElement) ANCHOR(s string) *ADDRESSElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ADDRESSElement) ANCHORF(format string, args ...any) *ADDRESSElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ADDRESSElement) IfANCHOR(condition bool, s string) *ADDRESSElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ADDRESSElement) IfANCHORF(condition bool, format string, args ...any) *ADDRESSElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *ADDRESSElement) ANCHORRemove(s string) *ADDRESSElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *ADDRESSElement) ANCHORRemoveF(format string, args ...any) *ADDRESSElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether and how text input is automatically capitalized as it is entered/edited
 // by the user
@@ -1771,6 +1828,96 @@ func (e *ADDRESSElement) POPOVERRemove(c AddressPopoverChoice) *ADDRESSElement {
 	return e
 }
 
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ADDRESSElement) PROPERTY(s ...string) *ADDRESSElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ADDRESSElement) IfPROPERTY(condition bool, s ...string) *ADDRESSElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *ADDRESSElement) PROPERTYRemove(s ...string) *ADDRESSElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ADDRESSElement) RESOURCE(s string) *ADDRESSElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ADDRESSElement) RESOURCEF(format string, args ...any) *ADDRESSElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ADDRESSElement) IfRESOURCE(condition bool, s string) *ADDRESSElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ADDRESSElement) IfRESOURCEF(condition bool, format string, args ...any) *ADDRESSElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *ADDRESSElement) RESOURCERemove(s string) *ADDRESSElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *ADDRESSElement) RESOURCERemoveF(format string, args ...any) *ADDRESSElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
 // The role global attribute is used to define the purpose or state of an element
 // to the browser, in order to facilitate assistive technology such as screen
 // readers
@@ -2340,6 +2487,96 @@ func (e *ADDRESSElement) TRANSLATERemove(c AddressTranslateChoice) *ADDRESSEleme
 	return e
 }
 
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ADDRESSElement) TYPEOF(s ...string) *ADDRESSElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *ADDRESSElement) IfTYPEOF(condition bool, s ...string) *ADDRESSElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *ADDRESSElement) TYPEOFRemove(s ...string) *ADDRESSElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ADDRESSElement) VOCAB(s string) *ADDRESSElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ADDRESSElement) VOCABF(format string, args ...any) *ADDRESSElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ADDRESSElement) IfVOCAB(condition bool, s string) *ADDRESSElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ADDRESSElement) IfVOCABF(condition bool, format string, args ...any) *ADDRESSElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *ADDRESSElement) VOCABRemove(s string) *ADDRESSElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *ADDRESSElement) VOCABRemoveF(format string, args ...any) *ADDRESSElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
 // Sets the value of any HTML attribute to an expression, and keeps it in sync.
 //
 // See: https://data-star.dev/reference/attributes#data-attr
@@ -592,6 +592,63 @@ func (e *AREAElement) ACCESSKEYRemove() *AREAElement {
 	return e
 }
 
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *AREAElement) ANCHOR(s string) *AREAElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *AREAElement) ANCHORF(format string, args ...any) *AREAElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *AREAElement) IfANCHOR(condition bool, s string) *AREAElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *AREAElement) IfANCHORF(condition bool, format string, args ...any) *AREAElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *AREAElement) ANCHORRemove(s string) *AREAElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *AREAElement) ANCHORRemoveF(format string, args ...any) *AREAElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether and how text input is automatically capitalized as it is entered/edited
 // by the user
@@ -2165,6 +2222,96 @@ func (e *AREAElement) POPOVERRemove(c AreaPopoverChoice) *AREAElement {
 	return e
 }
 
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *AREAElement) PROPERTY(s ...string) *AREAElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *AREAElement) IfPROPERTY(condition bool, s ...string) *AREAElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *AREAElement) PROPERTYRemove(s ...string) *AREAElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *AREAElement) RESOURCE(s string) *AREAElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *AREAElement) RESOURCEF(format string, args ...any) *AREAElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *AREAElement) IfRESOURCE(condition bool, s string) *AREAElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *AREAElement) IfRESOURCEF(condition bool, format string, args ...any) *AREAElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *AREAElement) RESOURCERemove(s string) *AREAElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *AREAElement) RESOURCERemoveF(format string, args ...any) *AREAElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
 // The role global attribute is used to define the purpose or state of an element
 // to the browser, in order to facilitate assistive technology such as screen
 // readers
@@ -2734,6 +2881,96 @@ func (e *AREAElement) TRANSLATERemove(c AreaTranslateChoice) *AREAElement {
 	return e
 }
 
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *AREAElement) TYPEOF(s ...string) *AREAElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *AREAElement) IfTYPEOF(condition bool, s ...string) *AREAElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *AREAElement) TYPEOFRemove(s ...string) *AREAElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *AREAElement) VOCAB(s string) *AREAElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *AREAElement) VOCABF(format string, args ...any) *AREAElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *AREAElement) IfVOCAB(condition bool, s string) *AREAElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *AREAElement) IfVOCABF(condition bool, format string, args ...any) *AREAElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *AREAElement) VOCABRemove(s string) *AREAElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *AREAElement) VOCABRemoveF(format string, args ...any) *AREAElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
 // Sets the value of any HTML attribute to an expression, and keeps it in sync.
 //
 // See: https://data-star.dev/reference/attributes#data-attr
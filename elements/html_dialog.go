@@ -164,6 +164,38 @@ func (e *DIALOGElement) CustomDataRemove(key string) *DIALOGElement {
 	return e
 }
 
+// Specifies which user actions are able to close the dialog.
+func (e *DIALOGElement) CLOSEDBY(c DialogClosedbyChoice) *DIALOGElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("closedby", string(c))
+	return e
+}
+
+type DialogClosedbyChoice string
+
+const (
+	// The dialog can be dismissed with a light dismiss user action, a
+	// platform-specific user action, or a developer-specified mechanism.
+	DialogClosedby_any DialogClosedbyChoice = "any"
+	// The dialog can be dismissed with a platform-specific user action or a
+	// developer-specified mechanism.
+	DialogClosedby_closerequest DialogClosedbyChoice = "closerequest"
+	// The dialog can only be dismissed with a developer-specified mechanism.
+	DialogClosedby_none DialogClosedbyChoice = "none"
+)
+
+// Specifies which user actions are able to close the dialog.
+// Remove the attribute CLOSEDBY from the element.
+func (e *DIALOGElement) CLOSEDBYRemove(c DialogClosedbyChoice) *DIALOGElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("closedby")
+	return e
+}
+
 // Indicates whether the dialog is showing.
 func (e *DIALOGElement) OPEN() *DIALOGElement {
 	e.OPENSet(true)
@@ -242,6 +274,63 @@ func (e *DIALOGElement) ACCESSKEYRemove() *DIALOGElement {
 	return e
 }
 
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *DIALOGElement) ANCHOR(s string) *DIALOGElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *DIALOGElement) ANCHORF(format string, args ...any) *DIALOGElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *DIALOGElement) IfANCHOR(condition bool, s string) *DIALOGElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *DIALOGElement) IfANCHORF(condition bool, format string, args ...any) *DIALOGElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *DIALOGElement) ANCHORRemove(s string) *DIALOGElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *DIALOGElement) ANCHORRemoveF(format string, args ...any) *DIALOGElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether and how text input is automatically capitalized as it is entered/edited
 // by the user
@@ -1815,6 +1904,96 @@ func (e *DIALOGElement) POPOVERRemove(c DialogPopoverChoice) *DIALOGElement {
 	return e
 }
 
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *DIALOGElement) PROPERTY(s ...string) *DIALOGElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *DIALOGElement) IfPROPERTY(condition bool, s ...string) *DIALOGElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *DIALOGElement) PROPERTYRemove(s ...string) *DIALOGElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *DIALOGElement) RESOURCE(s string) *DIALOGElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *DIALOGElement) RESOURCEF(format string, args ...any) *DIALOGElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *DIALOGElement) IfRESOURCE(condition bool, s string) *DIALOGElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *DIALOGElement) IfRESOURCEF(condition bool, format string, args ...any) *DIALOGElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *DIALOGElement) RESOURCERemove(s string) *DIALOGElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *DIALOGElement) RESOURCERemoveF(format string, args ...any) *DIALOGElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
 // The role global attribute is used to define the purpose or state of an element
 // to the browser, in order to facilitate assistive technology such as screen
 // readers
@@ -2384,6 +2563,96 @@ func (e *DIALOGElement) TRANSLATERemove(c DialogTranslateChoice) *DIALOGElement
 	return e
 }
 
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *DIALOGElement) TYPEOF(s ...string) *DIALOGElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *DIALOGElement) IfTYPEOF(condition bool, s ...string) *DIALOGElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *DIALOGElement) TYPEOFRemove(s ...string) *DIALOGElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *DIALOGElement) VOCAB(s string) *DIALOGElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *DIALOGElement) VOCABF(format string, args ...any) *DIALOGElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *DIALOGElement) IfVOCAB(condition bool, s string) *DIALOGElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *DIALOGElement) IfVOCABF(condition bool, format string, args ...any) *DIALOGElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *DIALOGElement) VOCABRemove(s string) *DIALOGElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *DIALOGElement) VOCABRemoveF(format string, args ...any) *DIALOGElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
 // Sets the value of any HTML attribute to an expression, and keeps it in sync.
 //
 // See: https://data-star.dev/reference/attributes#data-attr
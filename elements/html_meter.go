@@ -179,6 +179,16 @@ func (e *METERElement) IfHIGH(condition bool, f float64) *METERElement {
 	return e
 }
 
+// Indicates the range's upper bound.
+// Remove the attribute HIGH from the element.
+func (e *METERElement) HIGHRemove(f float64) *METERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("high")
+	return e
+}
+
 // Indicates the range's lower bound.
 func (e *METERElement) LOW(f float64) *METERElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *METERElement) IfLOW(condition bool, f float64) *METERElement {
 	return e
 }
 
+// Indicates the range's lower bound.
+// Remove the attribute LOW from the element.
+func (e *METERElement) LOWRemove(f float64) *METERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("low")
+	return e
+}
+
 // Indicates the maximum value allowed.
 func (e *METERElement) MAX(f float64) *METERElement {
 	if e.FloatAttributes == nil {
@@ -213,6 +233,16 @@ func (e *METERElement) IfMAX(condition bool, f float64) *METERElement {
 	return e
 }
 
+// Indicates the maximum value allowed.
+// Remove the attribute MAX from the element.
+func (e *METERElement) MAXRemove(f float64) *METERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("max")
+	return e
+}
+
 // Indicates the minimum value allowed.
 func (e *METERElement) MIN(f float64) *METERElement {
 	if e.FloatAttributes == nil {
@@ -230,6 +260,16 @@ func (e *METERElement) IfMIN(condition bool, f float64) *METERElement {
 	return e
 }
 
+// Indicates the minimum value allowed.
+// Remove the attribute MIN from the element.
+func (e *METERElement) MINRemove(f float64) *METERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("min")
+	return e
+}
+
 // Indicates the optimal numeric value.
 func (e *METERElement) OPTIMUM(f float64) *METERElement {
 	if e.FloatAttributes == nil {
@@ -247,6 +287,16 @@ func (e *METERElement) IfOPTIMUM(condition bool, f float64) *METERElement {
 	return e
 }
 
+// Indicates the optimal numeric value.
+// Remove the attribute OPTIMUM from the element.
+func (e *METERElement) OPTIMUMRemove(f float64) *METERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("optimum")
+	return e
+}
+
 // Current numeric value.
 func (e *METERElement) VALUE(f float64) *METERElement {
 	if e.FloatAttributes == nil {
@@ -264,6 +314,16 @@ func (e *METERElement) IfVALUE(condition bool, f float64) *METERElement {
 	return e
 }
 
+// Current numeric value.
+// Remove the attribute VALUE from the element.
+func (e *METERElement) VALUERemove(f float64) *METERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("value")
+	return e
+}
+
 // The accesskey global attribute provides a hint for generating a keyboard
 // shortcut for the current element
 // The attribute value must consist of a single printable character (which
@@ -300,6 +360,63 @@ func (e *METERElement) ACCESSKEYRemove() *METERElement {
 	return e
 }
 
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *METERElement) ANCHOR(s string) *METERElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *METERElement) ANCHORF(format string, args ...any) *METERElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *METERElement) IfANCHOR(condition bool, s string) *METERElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *METERElement) IfANCHORF(condition bool, format string, args ...any) *METERElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *METERElement) ANCHORRemove(s string) *METERElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *METERElement) ANCHORRemoveF(format string, args ...any) *METERElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether and how text input is automatically capitalized as it is entered/edited
 // by the user
@@ -1873,6 +1990,96 @@ func (e *METERElement) POPOVERRemove(c MeterPopoverChoice) *METERElement {
 	return e
 }
 
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *METERElement) PROPERTY(s ...string) *METERElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *METERElement) IfPROPERTY(condition bool, s ...string) *METERElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *METERElement) PROPERTYRemove(s ...string) *METERElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *METERElement) RESOURCE(s string) *METERElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *METERElement) RESOURCEF(format string, args ...any) *METERElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *METERElement) IfRESOURCE(condition bool, s string) *METERElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *METERElement) IfRESOURCEF(condition bool, format string, args ...any) *METERElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *METERElement) RESOURCERemove(s string) *METERElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *METERElement) RESOURCERemoveF(format string, args ...any) *METERElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
 // The role global attribute is used to define the purpose or state of an element
 // to the browser, in order to facilitate assistive technology such as screen
 // readers
@@ -2442,6 +2649,96 @@ func (e *METERElement) TRANSLATERemove(c MeterTranslateChoice) *METERElement {
 	return e
 }
 
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *METERElement) TYPEOF(s ...string) *METERElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *METERElement) IfTYPEOF(condition bool, s ...string) *METERElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *METERElement) TYPEOFRemove(s ...string) *METERElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *METERElement) VOCAB(s string) *METERElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *METERElement) VOCABF(format string, args ...any) *METERElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *METERElement) IfVOCAB(condition bool, s string) *METERElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *METERElement) IfVOCABF(condition bool, format string, args ...any) *METERElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *METERElement) VOCABRemove(s string) *METERElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *METERElement) VOCABRemoveF(format string, args ...any) *METERElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
 // Sets the value of any HTML attribute to an expression, and keeps it in sync.
 //
 // See: https://data-star.dev/reference/attributes#data-attr
@@ -179,6 +179,16 @@ func (e *PROGRESSElement) IfMAX(condition bool, f float64) *PROGRESSElement {
 	return e
 }
 
+// Upper bound of range.
+// Remove the attribute MAX from the element.
+func (e *PROGRESSElement) MAXRemove(f float64) *PROGRESSElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("max")
+	return e
+}
+
 // Current value of the element.
 func (e *PROGRESSElement) VALUE(f float64) *PROGRESSElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *PROGRESSElement) IfVALUE(condition bool, f float64) *PROGRESSElement {
 	return e
 }
 
+// Current value of the element.
+// Remove the attribute VALUE from the element.
+func (e *PROGRESSElement) VALUERemove(f float64) *PROGRESSElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("value")
+	return e
+}
+
 // The accesskey global attribute provides a hint for generating a keyboard
 // shortcut for the current element
 // The attribute value must consist of a single printable character (which
@@ -232,6 +252,63 @@ func (e *PROGRESSElement) ACCESSKEYRemove() *PROGRESSElement {
 	return e
 }
 
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *PROGRESSElement) ANCHOR(s string) *PROGRESSElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *PROGRESSElement) ANCHORF(format string, args ...any) *PROGRESSElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *PROGRESSElement) IfANCHOR(condition bool, s string) *PROGRESSElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *PROGRESSElement) IfANCHORF(condition bool, format string, args ...any) *PROGRESSElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *PROGRESSElement) ANCHORRemove(s string) *PROGRESSElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *PROGRESSElement) ANCHORRemoveF(format string, args ...any) *PROGRESSElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether and how text input is automatically capitalized as it is entered/edited
 // by the user
@@ -1805,6 +1882,96 @@ func (e *PROGRESSElement) POPOVERRemove(c ProgressPopoverChoice) *PROGRESSElemen
 	return e
 }
 
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *PROGRESSElement) PROPERTY(s ...string) *PROGRESSElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *PROGRESSElement) IfPROPERTY(condition bool, s ...string) *PROGRESSElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *PROGRESSElement) PROPERTYRemove(s ...string) *PROGRESSElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *PROGRESSElement) RESOURCE(s string) *PROGRESSElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *PROGRESSElement) RESOURCEF(format string, args ...any) *PROGRESSElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *PROGRESSElement) IfRESOURCE(condition bool, s string) *PROGRESSElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *PROGRESSElement) IfRESOURCEF(condition bool, format string, args ...any) *PROGRESSElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *PROGRESSElement) RESOURCERemove(s string) *PROGRESSElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *PROGRESSElement) RESOURCERemoveF(format string, args ...any) *PROGRESSElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
 // The role global attribute is used to define the purpose or state of an element
 // to the browser, in order to facilitate assistive technology such as screen
 // readers
@@ -2374,6 +2541,96 @@ func (e *PROGRESSElement) TRANSLATERemove(c ProgressTranslateChoice) *PROGRESSEl
 	return e
 }
 
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *PROGRESSElement) TYPEOF(s ...string) *PROGRESSElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *PROGRESSElement) IfTYPEOF(condition bool, s ...string) *PROGRESSElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *PROGRESSElement) TYPEOFRemove(s ...string) *PROGRESSElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *PROGRESSElement) VOCAB(s string) *PROGRESSElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *PROGRESSElement) VOCABF(format string, args ...any) *PROGRESSElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *PROGRESSElement) IfVOCAB(condition bool, s string) *PROGRESSElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *PROGRESSElement) IfVOCABF(condition bool, format string, args ...any) *PROGRESSElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *PROGRESSElement) VOCABRemove(s string) *PROGRESSElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *PROGRESSElement) VOCABRemoveF(format string, args ...any) *PROGRESSElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
 // Sets the value of any HTML attribute to an expression, and keeps it in sync.
 //
 // See: https://data-star.dev/reference/attributes#data-attr
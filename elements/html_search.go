@@ -0,0 +1,4211 @@
+// DO NOT EDIT THIS FILE. IT IS AUTOMATICALLY GENERATED.
+// package html search is generated from configuration file.
+// Description:
+package elements
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/igrmk/treemap/v2"
+	"github.com/samber/lo"
+)
+
+// The HTML <search> element is a container representing the parts of the document
+// or application with form controls or other content related to performing a
+// search or filtering operation
+// The <search> element semantically identifies the purpose of the element's
+// contents as having search or filtering capabilities.
+type SEARCHElement struct {
+	*Element
+}
+
+// Create a new SEARCHElement element.
+// This will create a new element with the tag
+// "search" during rendering.
+func SEARCH(children ...ElementRenderer) *SEARCHElement {
+	e := NewElement("search", children...)
+	e.IsSelfClosing = false
+	e.Descendants = children
+
+	return &SEARCHElement{Element: e}
+}
+
+func (e *SEARCHElement) Children(children ...ElementRenderer) *SEARCHElement {
+	e.Descendants = append(e.Descendants, children...)
+	return e
+}
+
+func (e *SEARCHElement) IfChildren(condition bool, children ...ElementRenderer) *SEARCHElement {
+	if condition {
+		e.Descendants = append(e.Descendants, children...)
+	}
+	return e
+}
+
+func (e *SEARCHElement) TernChildren(condition bool, trueChildren, falseChildren ElementRenderer) *SEARCHElement {
+	if condition {
+		e.Descendants = append(e.Descendants, trueChildren)
+	} else {
+		e.Descendants = append(e.Descendants, falseChildren)
+	}
+	return e
+}
+
+func (e *SEARCHElement) Attr(name string, value string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set(name, value)
+	return e
+}
+
+func (e *SEARCHElement) Attrs(attrs ...string) *SEARCHElement {
+	if len(attrs)%2 != 0 {
+		panic("attrs must be a multiple of 2")
+	}
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	for i := 0; i < len(attrs); i += 2 {
+		k := attrs[i]
+		v := attrs[i+1]
+		e.StringAttributes.Set(k, v)
+	}
+	return e
+}
+
+func (e *SEARCHElement) AttrsMap(attrs map[string]string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	for k, v := range attrs {
+		e.StringAttributes.Set(k, v)
+	}
+	return e
+}
+
+func (e *SEARCHElement) Text(text string) *SEARCHElement {
+	e.Descendants = append(e.Descendants, Text(text))
+	return e
+}
+
+func (e *SEARCHElement) TextF(format string, args ...any) *SEARCHElement {
+	return e.Text(fmt.Sprintf(format, args...))
+}
+
+func (e *SEARCHElement) IfText(condition bool, text string) *SEARCHElement {
+	if condition {
+		e.Descendants = append(e.Descendants, Text(text))
+	}
+	return e
+}
+
+func (e *SEARCHElement) IfTextF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.Descendants = append(e.Descendants, Text(fmt.Sprintf(format, args...)))
+	}
+	return e
+}
+
+func (e *SEARCHElement) Escaped(text string) *SEARCHElement {
+	e.Descendants = append(e.Descendants, Escaped(text))
+	return e
+}
+
+func (e *SEARCHElement) IfEscaped(condition bool, text string) *SEARCHElement {
+	if condition {
+		e.Descendants = append(e.Descendants, Escaped(text))
+	}
+	return e
+}
+
+func (e *SEARCHElement) EscapedF(format string, args ...any) *SEARCHElement {
+	return e.Escaped(fmt.Sprintf(format, args...))
+}
+
+func (e *SEARCHElement) IfEscapedF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.Descendants = append(e.Descendants, EscapedF(format, args...))
+	}
+	return e
+}
+
+func (e *SEARCHElement) CustomData(key, value string) *SEARCHElement {
+	if e.CustomDataAttributes == nil {
+		e.CustomDataAttributes = treemap.New[string, string]()
+	}
+	e.CustomDataAttributes.Set(key, value)
+	return e
+}
+
+func (e *SEARCHElement) IfCustomData(condition bool, key, value string) *SEARCHElement {
+	if condition {
+		e.CustomData(key, value)
+	}
+	return e
+}
+
+func (e *SEARCHElement) CustomDataF(key, format string, args ...any) *SEARCHElement {
+	return e.CustomData(key, fmt.Sprintf(format, args...))
+}
+
+func (e *SEARCHElement) IfCustomDataF(condition bool, key, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.CustomData(key, fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+func (e *SEARCHElement) CustomDataRemove(key string) *SEARCHElement {
+	if e.CustomDataAttributes == nil {
+		return e
+	}
+	e.CustomDataAttributes.Del(key)
+	return e
+}
+
+// The accesskey global attribute provides a hint for generating a keyboard
+// shortcut for the current element
+// The attribute value must consist of a single printable character (which
+// includes accented and other characters that can be generated by the keyboard).
+func (e *SEARCHElement) ACCESSKEY(r rune) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("accesskey", string(r))
+	return e
+}
+
+// The accesskey global attribute provides a hint for generating a keyboard
+// shortcut for the current element
+// The attribute value must consist of a single printable character (which
+// includes accented and other characters that can be generated by the keyboard).
+func (e *SEARCHElement) IfACCESSKEY(condition bool, r rune) *SEARCHElement {
+	if condition {
+		e.ACCESSKEY(r)
+	}
+	return e
+}
+
+// The accesskey global attribute provides a hint for generating a keyboard
+// shortcut for the current element
+// The attribute value must consist of a single printable character (which
+// includes accented and other characters that can be generated by the keyboard).
+// Remove the attribute ACCESSKEY from the element.
+func (e *SEARCHElement) ACCESSKEYRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("accesskey")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *SEARCHElement) ANCHOR(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("anchor", s)
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *SEARCHElement) ANCHORF(format string, args ...any) *SEARCHElement {
+	return e.ANCHOR(fmt.Sprintf(format, args...))
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *SEARCHElement) IfANCHOR(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ANCHOR(s)
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *SEARCHElement) IfANCHORF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ANCHOR(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+// Remove the attribute ANCHOR from the element.
+func (e *SEARCHElement) ANCHORRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("anchor")
+	return e
+}
+
+// The anchor attribute associates a positioned element with its anchor element by
+// id, for use with CSS anchor positioning
+// This attribute is experimental.
+func (e *SEARCHElement) ANCHORRemoveF(format string, args ...any) *SEARCHElement {
+	return e.ANCHORRemove(fmt.Sprintf(format, args...))
+}
+
+// The autocapitalize global attribute is an enumerated attribute that controls
+// whether and how text input is automatically capitalized as it is entered/edited
+// by the user
+// autocapitalize can be set on <input> and <textarea> elements, and on their
+// containing <form> elements
+// When autocapitalize is set on a <form> element, it sets the autocapitalize
+// behavior for all contained <input>s and <textarea>s, overriding any
+// autocapitalize values set on contained elements
+// autocapitalize has no effect on the url, email, or password <input> types,
+// where autocapitalization is never enabled
+// Where autocapitalize is not specified, the adopted default behavior varies
+// between browsers
+// For example: Chrome and Safari default to on/sentences Firefox defaults to
+// off/none.
+func (e *SEARCHElement) AUTOCAPITALIZE(c SearchAutocapitalizeChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("autocapitalize", string(c))
+	return e
+}
+
+type SearchAutocapitalizeChoice string
+
+const (
+	// Do not automatically capitalize any text.
+	SearchAutocapitalize_off SearchAutocapitalizeChoice = "off"
+	// Do not automatically capitalize any text.
+	SearchAutocapitalize_none SearchAutocapitalizeChoice = "none"
+	// Automatically capitalize the first character of each sentence.
+	SearchAutocapitalize_sentences SearchAutocapitalizeChoice = "sentences"
+	// Automatically capitalize the first character of each sentence.
+	SearchAutocapitalize_on SearchAutocapitalizeChoice = "on"
+	// Automatically capitalize the first character of each word.
+	SearchAutocapitalize_words SearchAutocapitalizeChoice = "words"
+	// Automatically capitalize all characters.
+	SearchAutocapitalize_characters SearchAutocapitalizeChoice = "characters"
+)
+
+// The autocapitalize global attribute is an enumerated attribute that controls
+// whether and how text input is automatically capitalized as it is entered/edited
+// by the user
+// autocapitalize can be set on <input> and <textarea> elements, and on their
+// containing <form> elements
+// When autocapitalize is set on a <form> element, it sets the autocapitalize
+// behavior for all contained <input>s and <textarea>s, overriding any
+// autocapitalize values set on contained elements
+// autocapitalize has no effect on the url, email, or password <input> types,
+// where autocapitalization is never enabled
+// Where autocapitalize is not specified, the adopted default behavior varies
+// between browsers
+// For example: Chrome and Safari default to on/sentences Firefox defaults to
+// off/none.
+// Remove the attribute AUTOCAPITALIZE from the element.
+func (e *SEARCHElement) AUTOCAPITALIZERemove(c SearchAutocapitalizeChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("autocapitalize")
+	return e
+}
+
+// The autofocus global attribute is a Boolean attribute indicating that an
+// element should be focused on page load, or when the <dialog> that it is part of
+// is displayed.
+//
+//	Accessibility concerns Automatically focusing a form control can confuse
+//
+// visually-impaired people using screen-reading technology and people with
+// cognitive impairments
+// When autofocus is assigned, screen-readers "teleport" their user to the form
+// control without warning them beforehand.
+//
+//	Use careful consideration for accessibility when applying the autofocus
+//
+// attribute
+// Automatically focusing on a control can cause the page to scroll on load
+// The focus can also cause dynamic keyboards to display on some touch devices
+// While a screen reader will announce the label of the form control receiving
+// focus, the screen reader will not announce anything before the label, and the
+// sighted user on a small device will equally miss the context created by the
+// preceding content.
+func (e *SEARCHElement) AUTOFOCUS() *SEARCHElement {
+	e.AUTOFOCUSSet(true)
+	return e
+}
+
+// The autofocus global attribute is a Boolean attribute indicating that an
+// element should be focused on page load, or when the <dialog> that it is part of
+// is displayed.
+//
+//	Accessibility concerns Automatically focusing a form control can confuse
+//
+// visually-impaired people using screen-reading technology and people with
+// cognitive impairments
+// When autofocus is assigned, screen-readers "teleport" their user to the form
+// control without warning them beforehand.
+//
+//	Use careful consideration for accessibility when applying the autofocus
+//
+// attribute
+// Automatically focusing on a control can cause the page to scroll on load
+// The focus can also cause dynamic keyboards to display on some touch devices
+// While a screen reader will announce the label of the form control receiving
+// focus, the screen reader will not announce anything before the label, and the
+// sighted user on a small device will equally miss the context created by the
+// preceding content.
+func (e *SEARCHElement) IfAUTOFOCUS(condition bool) *SEARCHElement {
+	if condition {
+		e.AUTOFOCUSSet(true)
+	}
+	return e
+}
+
+// The autofocus global attribute is a Boolean attribute indicating that an
+// element should be focused on page load, or when the <dialog> that it is part of
+// is displayed.
+//
+//	Accessibility concerns Automatically focusing a form control can confuse
+//
+// visually-impaired people using screen-reading technology and people with
+// cognitive impairments
+// When autofocus is assigned, screen-readers "teleport" their user to the form
+// control without warning them beforehand.
+//
+//	Use careful consideration for accessibility when applying the autofocus
+//
+// attribute
+// Automatically focusing on a control can cause the page to scroll on load
+// The focus can also cause dynamic keyboards to display on some touch devices
+// While a screen reader will announce the label of the form control receiving
+// focus, the screen reader will not announce anything before the label, and the
+// sighted user on a small device will equally miss the context created by the
+// preceding content.
+// Set the attribute AUTOFOCUS to the value b explicitly.
+func (e *SEARCHElement) AUTOFOCUSSet(b bool) *SEARCHElement {
+	if e.BoolAttributes == nil {
+		e.BoolAttributes = treemap.New[string, bool]()
+	}
+	e.BoolAttributes.Set("autofocus", b)
+	return e
+}
+
+// The autofocus global attribute is a Boolean attribute indicating that an
+// element should be focused on page load, or when the <dialog> that it is part of
+// is displayed.
+//
+//	Accessibility concerns Automatically focusing a form control can confuse
+//
+// visually-impaired people using screen-reading technology and people with
+// cognitive impairments
+// When autofocus is assigned, screen-readers "teleport" their user to the form
+// control without warning them beforehand.
+//
+//	Use careful consideration for accessibility when applying the autofocus
+//
+// attribute
+// Automatically focusing on a control can cause the page to scroll on load
+// The focus can also cause dynamic keyboards to display on some touch devices
+// While a screen reader will announce the label of the form control receiving
+// focus, the screen reader will not announce anything before the label, and the
+// sighted user on a small device will equally miss the context created by the
+// preceding content.
+func (e *SEARCHElement) IfSetAUTOFOCUS(condition bool, b bool) *SEARCHElement {
+	if condition {
+		e.AUTOFOCUSSet(b)
+	}
+	return e
+}
+
+// Remove the attribute AUTOFOCUS from the element.
+// The autofocus global attribute is a Boolean attribute indicating that an
+// element should be focused on page load, or when the <dialog> that it is part of
+// is displayed.
+//
+//	Accessibility concerns Automatically focusing a form control can confuse
+//
+// visually-impaired people using screen-reading technology and people with
+// cognitive impairments
+// When autofocus is assigned, screen-readers "teleport" their user to the form
+// control without warning them beforehand.
+//
+//	Use careful consideration for accessibility when applying the autofocus
+//
+// attribute
+// Automatically focusing on a control can cause the page to scroll on load
+// The focus can also cause dynamic keyboards to display on some touch devices
+// While a screen reader will announce the label of the form control receiving
+// focus, the screen reader will not announce anything before the label, and the
+// sighted user on a small device will equally miss the context created by the
+// preceding content.
+func (e *SEARCHElement) AUTOFOCUSRemove(b bool) *SEARCHElement {
+	if e.BoolAttributes == nil {
+		return e
+	}
+	e.BoolAttributes.Del("autofocus")
+	return e
+}
+
+// The class global attribute is a space-separated list of the case-sensitive
+// classes of the element
+// Classes allow CSS and JavaScript to select and access specific elements via the
+// class selectors or functions like the DOM method
+// document.getElementsByClassName.
+func (e *SEARCHElement) CLASS(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("class")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("class", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The class global attribute is a space-separated list of the case-sensitive
+// classes of the element
+// Classes allow CSS and JavaScript to select and access specific elements via the
+// class selectors or functions like the DOM method
+// document.getElementsByClassName.
+func (e *SEARCHElement) IfCLASS(condition bool, s ...string) *SEARCHElement {
+	if condition {
+		e.CLASS(s...)
+	}
+	return e
+}
+
+// The class global attribute is a space-separated list of the case-sensitive
+// classes of the element
+// Classes allow CSS and JavaScript to select and access specific elements via the
+// class selectors or functions like the DOM method
+// document.getElementsByClassName.
+// Remove the attribute CLASS from the element.
+func (e *SEARCHElement) CLASSRemove(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("class")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The contenteditable global attribute is an enumerated attribute indicating if
+// the element should be editable by the user
+// If so, the browser modifies its widget to allow editing.
+func (e *SEARCHElement) CONTENTEDITABLE(c SearchContenteditableChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("contenteditable", string(c))
+	return e
+}
+
+type SearchContenteditableChoice string
+
+const (
+	// The element is editable.
+	SearchContenteditable_empty SearchContenteditableChoice = ""
+	// The element is editable.
+	SearchContenteditable_true SearchContenteditableChoice = "true"
+	// The element is not editable.
+	SearchContenteditable_false SearchContenteditableChoice = "false"
+	// which indicates that the element's raw text is editable, but rich text
+	// formatting is disabled.
+	SearchContenteditable_plaintext_only SearchContenteditableChoice = "plaintext-only"
+)
+
+// The contenteditable global attribute is an enumerated attribute indicating if
+// the element should be editable by the user
+// If so, the browser modifies its widget to allow editing.
+// Remove the attribute CONTENTEDITABLE from the element.
+func (e *SEARCHElement) CONTENTEDITABLERemove(c SearchContenteditableChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("contenteditable")
+	return e
+}
+
+// The dir global attribute is an enumerated attribute that indicates the
+// directionality of the element's text
+// Note: This attribute is mandatory for the <bdo> element where it has a
+// different semantic meaning
+// This attribute is not inherited by the <bdi> element
+// If not set, its value is auto
+// This attribute can be overridden by the CSS properties direction and
+// unicode-bidi, if a CSS page is active and the element supports these properties
+// As the directionality of the text is semantically related to its content and
+// not to its presentation, it is recommended that web developers use this
+// attribute instead of the related CSS properties when possible
+// That way, the text will display correctly even on a browser that doesn't
+// support CSS or has the CSS deactivated
+// The auto value should be used for data with an unknown directionality, like
+// data coming from user input, eventually stored in a database.
+func (e *SEARCHElement) DIR(c SearchDirChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("dir", string(c))
+	return e
+}
+
+type SearchDirChoice string
+
+const (
+	// which means left to right and is to be used for languages that are written from
+	// the left to the right (like English);
+	SearchDir_ltr SearchDirChoice = "ltr"
+	// which means right to left and is to be used for languages that are written from
+	// the right to the left (like Arabic);
+	SearchDir_rtl SearchDirChoice = "rtl"
+	// which lets the user agent decide
+	// It uses a basic algorithm as it parses the characters inside the element until
+	// it finds a character with a strong directionality, then it applies that
+	// directionality to the whole element.
+	SearchDir_auto SearchDirChoice = "auto"
+)
+
+// The dir global attribute is an enumerated attribute that indicates the
+// directionality of the element's text
+// Note: This attribute is mandatory for the <bdo> element where it has a
+// different semantic meaning
+// This attribute is not inherited by the <bdi> element
+// If not set, its value is auto
+// This attribute can be overridden by the CSS properties direction and
+// unicode-bidi, if a CSS page is active and the element supports these properties
+// As the directionality of the text is semantically related to its content and
+// not to its presentation, it is recommended that web developers use this
+// attribute instead of the related CSS properties when possible
+// That way, the text will display correctly even on a browser that doesn't
+// support CSS or has the CSS deactivated
+// The auto value should be used for data with an unknown directionality, like
+// data coming from user input, eventually stored in a database.
+// Remove the attribute DIR from the element.
+func (e *SEARCHElement) DIRRemove(c SearchDirChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("dir")
+	return e
+}
+
+// The draggable global attribute is an enumerated attribute that indicates
+// whether the element can be dragged, either with native browser behavior or the
+// HTML Drag and Drop API.
+func (e *SEARCHElement) DRAGGABLE(c SearchDraggableChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("draggable", string(c))
+	return e
+}
+
+type SearchDraggableChoice string
+
+const (
+	// The element is draggable.
+	SearchDraggable_true SearchDraggableChoice = "true"
+	// The element is not draggable.
+	SearchDraggable_false SearchDraggableChoice = "false"
+	// drag behavior is the default browser behavior: only text selections, images,
+	// and links can be dragged
+	// For other elements, the event ondragstart must be set for drag and drop to work
+	SearchDraggable_empty SearchDraggableChoice = ""
+	// drag behavior is the default browser behavior: only text selections, images,
+	// and links can be dragged
+	// For other elements, the event ondragstart must be set for drag and drop to work
+	SearchDraggable_auto SearchDraggableChoice = "auto"
+)
+
+// The draggable global attribute is an enumerated attribute that indicates
+// whether the element can be dragged, either with native browser behavior or the
+// HTML Drag and Drop API.
+// Remove the attribute DRAGGABLE from the element.
+func (e *SEARCHElement) DRAGGABLERemove(c SearchDraggableChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("draggable")
+	return e
+}
+
+// The enterkeyhint global attribute is an enumerated attribute defining what
+// action label (or icon) to present for the enter key on virtual keyboards.
+func (e *SEARCHElement) ENTERKEYHINT(c SearchEnterkeyhintChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("enterkeyhint", string(c))
+	return e
+}
+
+type SearchEnterkeyhintChoice string
+
+const (
+	// Typically inserting a new line.
+	SearchEnterkeyhint_enter SearchEnterkeyhintChoice = "enter"
+	// Typically meaning there is nothing more to input and the input method editor
+	// (IME) will be closed.
+	SearchEnterkeyhint_done SearchEnterkeyhintChoice = "done"
+	// Typically meaning to take the user to the target of the text they typed.
+	SearchEnterkeyhint_go SearchEnterkeyhintChoice = "go"
+	// Typically meaning to take the user to the next field that will accept text.
+	SearchEnterkeyhint_next SearchEnterkeyhintChoice = "next"
+	// Typically meaning to take the user to the previous field that will accept text.
+	SearchEnterkeyhint_previous SearchEnterkeyhintChoice = "previous"
+	// Typically taking the user to the results of searching for the text they have
+	// typed.
+	SearchEnterkeyhint_search SearchEnterkeyhintChoice = "search"
+	// Typically delivering the text to its target.
+	SearchEnterkeyhint_send SearchEnterkeyhintChoice = "send"
+)
+
+// The enterkeyhint global attribute is an enumerated attribute defining what
+// action label (or icon) to present for the enter key on virtual keyboards.
+// Remove the attribute ENTERKEYHINT from the element.
+func (e *SEARCHElement) ENTERKEYHINTRemove(c SearchEnterkeyhintChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("enterkeyhint")
+	return e
+}
+
+// The exportparts global attribute allows you to select and style elements
+// existing in nested shadow trees, by exporting their part names
+// The shadow tree is an isolated structure where identifiers, classes, and styles
+// cannot be reached by selectors or queries belonging to a regular DOM
+// To apply a style to an element living in a shadow tree, by CSS rule created
+// outside of it, part global attribute has to be used
+// It has to be assigned to an element present in Shadow Tree, and its value
+// should be some identifier
+// Rules present outside of the shadow tree, must use the ::part pseudo-element,
+// containing the same identifier as the argument
+// The global attribute part makes the element visible on just a single level of
+// depth
+// When the shadow tree is nested, parts will be visible only to the parent of the
+// shadow tree but not to its ancestor
+// Exporting parts further down is exactly what exportparts attribute is for
+// Attribute exportparts must be placed on a shadow Host, which is the element to
+// which the shadow tree is attached
+// The value of the attribute should be a comma-separated list of part names
+// present in the shadow tree and which should be made available via a DOM outside
+// of the current structure.
+func (e *SEARCHElement) EXPORTPARTS(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("exportparts")
+	if !ok {
+		ds = NewDelimitedBuilder[string](",")
+		e.DelimitedStrings.Set("exportparts", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The exportparts global attribute allows you to select and style elements
+// existing in nested shadow trees, by exporting their part names
+// The shadow tree is an isolated structure where identifiers, classes, and styles
+// cannot be reached by selectors or queries belonging to a regular DOM
+// To apply a style to an element living in a shadow tree, by CSS rule created
+// outside of it, part global attribute has to be used
+// It has to be assigned to an element present in Shadow Tree, and its value
+// should be some identifier
+// Rules present outside of the shadow tree, must use the ::part pseudo-element,
+// containing the same identifier as the argument
+// The global attribute part makes the element visible on just a single level of
+// depth
+// When the shadow tree is nested, parts will be visible only to the parent of the
+// shadow tree but not to its ancestor
+// Exporting parts further down is exactly what exportparts attribute is for
+// Attribute exportparts must be placed on a shadow Host, which is the element to
+// which the shadow tree is attached
+// The value of the attribute should be a comma-separated list of part names
+// present in the shadow tree and which should be made available via a DOM outside
+// of the current structure.
+func (e *SEARCHElement) IfEXPORTPARTS(condition bool, s ...string) *SEARCHElement {
+	if condition {
+		e.EXPORTPARTS(s...)
+	}
+	return e
+}
+
+// The exportparts global attribute allows you to select and style elements
+// existing in nested shadow trees, by exporting their part names
+// The shadow tree is an isolated structure where identifiers, classes, and styles
+// cannot be reached by selectors or queries belonging to a regular DOM
+// To apply a style to an element living in a shadow tree, by CSS rule created
+// outside of it, part global attribute has to be used
+// It has to be assigned to an element present in Shadow Tree, and its value
+// should be some identifier
+// Rules present outside of the shadow tree, must use the ::part pseudo-element,
+// containing the same identifier as the argument
+// The global attribute part makes the element visible on just a single level of
+// depth
+// When the shadow tree is nested, parts will be visible only to the parent of the
+// shadow tree but not to its ancestor
+// Exporting parts further down is exactly what exportparts attribute is for
+// Attribute exportparts must be placed on a shadow Host, which is the element to
+// which the shadow tree is attached
+// The value of the attribute should be a comma-separated list of part names
+// present in the shadow tree and which should be made available via a DOM outside
+// of the current structure.
+// Remove the attribute EXPORTPARTS from the element.
+func (e *SEARCHElement) EXPORTPARTSRemove(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("exportparts")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The hidden global attribute is a Boolean attribute indicating that the element
+// is not yet, or is no longer, relevant
+// For example, it can be used to hide elements of the page that can't be used
+// until the login process has been completed
+// Note that browsers typically implement hidden until found using
+// content-visibility: hidden
+// This means that unlike elements in the hidden state, elements in the hidden
+// until found state will have generated boxes, meaning that: the element will
+// participate in page layout margin, borders, padding, and background for the
+// element will be rendered
+// Also, the element needs to be affected by layout containment in order to be
+// revealed
+// This means that if the element in the hidden until found state has a display
+// value of none, contents, or inline, then the element will not be revealed by
+// find in page or fragment navigation.
+func (e *SEARCHElement) HIDDEN(c SearchHiddenChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("hidden", string(c))
+	return e
+}
+
+type SearchHiddenChoice string
+
+const (
+	// set the element to the hidden state
+	// Additionally, invalid values set the element to the hidden state.
+	SearchHidden_empty SearchHiddenChoice = ""
+	// set the element to the hidden state
+	// Additionally, invalid values set the element to the hidden state.
+	SearchHidden_hidden SearchHiddenChoice = "hidden"
+	// the element is hidden but its content will be accessible to the browser's "find
+	// in page" feature or to fragment navigation
+	// When these features cause a scroll to an element in a hidden until found
+	// subtree, the browser will fire a beforematch event on the hidden element remove
+	// the hidden attribute from the element scroll to the element
+	//
+	SearchHidden_until_found SearchHiddenChoice = "until-found"
+)
+
+// The hidden global attribute is a Boolean attribute indicating that the element
+// is not yet, or is no longer, relevant
+// For example, it can be used to hide elements of the page that can't be used
+// until the login process has been completed
+// Note that browsers typically implement hidden until found using
+// content-visibility: hidden
+// This means that unlike elements in the hidden state, elements in the hidden
+// until found state will have generated boxes, meaning that: the element will
+// participate in page layout margin, borders, padding, and background for the
+// element will be rendered
+// Also, the element needs to be affected by layout containment in order to be
+// revealed
+// This means that if the element in the hidden until found state has a display
+// value of none, contents, or inline, then the element will not be revealed by
+// find in page or fragment navigation.
+// Remove the attribute HIDDEN from the element.
+func (e *SEARCHElement) HIDDENRemove(c SearchHiddenChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("hidden")
+	return e
+}
+
+// The id global attribute defines a unique identifier (ID) which must be unique
+// in the whole document
+// Its purpose is to identify the element when linking (using a fragment
+// identifier), scripting, or styling (with CSS).
+func (e *SEARCHElement) ID(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("id", s)
+	return e
+}
+
+// The id global attribute defines a unique identifier (ID) which must be unique
+// in the whole document
+// Its purpose is to identify the element when linking (using a fragment
+// identifier), scripting, or styling (with CSS).
+func (e *SEARCHElement) IDF(format string, args ...any) *SEARCHElement {
+	return e.ID(fmt.Sprintf(format, args...))
+}
+
+// The id global attribute defines a unique identifier (ID) which must be unique
+// in the whole document
+// Its purpose is to identify the element when linking (using a fragment
+// identifier), scripting, or styling (with CSS).
+func (e *SEARCHElement) IfID(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ID(s)
+	}
+	return e
+}
+
+// The id global attribute defines a unique identifier (ID) which must be unique
+// in the whole document
+// Its purpose is to identify the element when linking (using a fragment
+// identifier), scripting, or styling (with CSS).
+func (e *SEARCHElement) IfIDF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ID(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The id global attribute defines a unique identifier (ID) which must be unique
+// in the whole document
+// Its purpose is to identify the element when linking (using a fragment
+// identifier), scripting, or styling (with CSS).
+// Remove the attribute ID from the element.
+func (e *SEARCHElement) IDRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("id")
+	return e
+}
+
+// The id global attribute defines a unique identifier (ID) which must be unique
+// in the whole document
+// Its purpose is to identify the element when linking (using a fragment
+// identifier), scripting, or styling (with CSS).
+func (e *SEARCHElement) IDRemoveF(format string, args ...any) *SEARCHElement {
+	return e.IDRemove(fmt.Sprintf(format, args...))
+}
+
+// The inert global attribute is a Boolean attribute indicating that the browser
+// will ignore the element
+// With the inert attribute, all of the element's flat tree descendants (such as
+// modal <dialog>s) that don't otherwise escape inertness are ignored
+// The inert attribute also makes the browser ignore input events sent by the
+// user, including focus-related events and events from assistive technologies
+// Specifically, inert does the following: Prevents the click event from being
+// fired when the user clicks on the element
+// Prevents the focus event from being raised by preventing the element from
+// gaining focus
+// Hides the element and its content from assistive technologies by excluding them
+// from the accessibility tree.
+func (e *SEARCHElement) INERT() *SEARCHElement {
+	e.INERTSet(true)
+	return e
+}
+
+// The inert global attribute is a Boolean attribute indicating that the browser
+// will ignore the element
+// With the inert attribute, all of the element's flat tree descendants (such as
+// modal <dialog>s) that don't otherwise escape inertness are ignored
+// The inert attribute also makes the browser ignore input events sent by the
+// user, including focus-related events and events from assistive technologies
+// Specifically, inert does the following: Prevents the click event from being
+// fired when the user clicks on the element
+// Prevents the focus event from being raised by preventing the element from
+// gaining focus
+// Hides the element and its content from assistive technologies by excluding them
+// from the accessibility tree.
+func (e *SEARCHElement) IfINERT(condition bool) *SEARCHElement {
+	if condition {
+		e.INERTSet(true)
+	}
+	return e
+}
+
+// The inert global attribute is a Boolean attribute indicating that the browser
+// will ignore the element
+// With the inert attribute, all of the element's flat tree descendants (such as
+// modal <dialog>s) that don't otherwise escape inertness are ignored
+// The inert attribute also makes the browser ignore input events sent by the
+// user, including focus-related events and events from assistive technologies
+// Specifically, inert does the following: Prevents the click event from being
+// fired when the user clicks on the element
+// Prevents the focus event from being raised by preventing the element from
+// gaining focus
+// Hides the element and its content from assistive technologies by excluding them
+// from the accessibility tree.
+// Set the attribute INERT to the value b explicitly.
+func (e *SEARCHElement) INERTSet(b bool) *SEARCHElement {
+	if e.BoolAttributes == nil {
+		e.BoolAttributes = treemap.New[string, bool]()
+	}
+	e.BoolAttributes.Set("inert", b)
+	return e
+}
+
+// The inert global attribute is a Boolean attribute indicating that the browser
+// will ignore the element
+// With the inert attribute, all of the element's flat tree descendants (such as
+// modal <dialog>s) that don't otherwise escape inertness are ignored
+// The inert attribute also makes the browser ignore input events sent by the
+// user, including focus-related events and events from assistive technologies
+// Specifically, inert does the following: Prevents the click event from being
+// fired when the user clicks on the element
+// Prevents the focus event from being raised by preventing the element from
+// gaining focus
+// Hides the element and its content from assistive technologies by excluding them
+// from the accessibility tree.
+func (e *SEARCHElement) IfSetINERT(condition bool, b bool) *SEARCHElement {
+	if condition {
+		e.INERTSet(b)
+	}
+	return e
+}
+
+// Remove the attribute INERT from the element.
+// The inert global attribute is a Boolean attribute indicating that the browser
+// will ignore the element
+// With the inert attribute, all of the element's flat tree descendants (such as
+// modal <dialog>s) that don't otherwise escape inertness are ignored
+// The inert attribute also makes the browser ignore input events sent by the
+// user, including focus-related events and events from assistive technologies
+// Specifically, inert does the following: Prevents the click event from being
+// fired when the user clicks on the element
+// Prevents the focus event from being raised by preventing the element from
+// gaining focus
+// Hides the element and its content from assistive technologies by excluding them
+// from the accessibility tree.
+func (e *SEARCHElement) INERTRemove(b bool) *SEARCHElement {
+	if e.BoolAttributes == nil {
+		return e
+	}
+	e.BoolAttributes.Del("inert")
+	return e
+}
+
+// The inputmode global attribute is an enumerated attribute that hints at the
+// type of data that might be entered by the user while editing the element or its
+// contents
+// This allows a browser to display an appropriate virtual keyboard
+// It is used primarily on <input> elements, but is usable on any element in
+// contenteditable mode
+// It's important to understand that the inputmode attribute doesn't cause any
+// validity requirements to be enforced on input
+// To require that input conforms to a particular data type, choose an appropriate
+// <input> element type
+// For specific guidance on choosing <input> types, see the Values section.
+func (e *SEARCHElement) INPUTMODE(c SearchInputmodeChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("inputmode", string(c))
+	return e
+}
+
+type SearchInputmodeChoice string
+
+const (
+	// No virtual keyboard
+	// For when the page implements its own keyboard input control.
+	SearchInputmode_none SearchInputmodeChoice = "none"
+	// Standard input keyboard for the user's current locale.
+	SearchInputmode_empty SearchInputmodeChoice = ""
+	// Standard input keyboard for the user's current locale.
+	SearchInputmode_text SearchInputmodeChoice = "text"
+	// Fractional numeric input keyboard containing the digits and decimal separator
+	// for the user's locale (typically
+	// or ,)
+	// Devices may or may not show a minus key (-).
+	SearchInputmode_decimal SearchInputmodeChoice = "decimal"
+	// Numeric input keyboard, but only requires the digits 0–9
+	// Devices may or may not show a minus key.
+	SearchInputmode_numeric SearchInputmodeChoice = "numeric"
+	// A telephone keypad input, including the digits 0–9, the asterisk (*), and the
+	// pound (#) key
+	// Inputs that *require* a telephone number should typically use <input
+	// type="tel"> instead.
+	SearchInputmode_tel SearchInputmodeChoice = "tel"
+	// A virtual keyboard optimized for search input
+	// For instance, the return/submit key may be labeled "Search", along with
+	// possible other optimizations
+	// Inputs that require a search query should typically use <input type="search">
+	// instead.
+	SearchInputmode_search SearchInputmodeChoice = "search"
+	// A virtual keyboard optimized for entering email addresses
+	// Typically includes the @character as well as other optimizations
+	// Inputs that require email addresses should typically use <input type="email">
+	// instead.
+	SearchInputmode_email SearchInputmodeChoice = "email"
+	// A keypad optimized for entering URLs
+	// This may have the / key more prominent, for example
+	// Enhanced features could include history access and so on
+	// Inputs that require a URL should typically use <input type="url"> instead.
+	SearchInputmode_url SearchInputmodeChoice = "url"
+)
+
+// The inputmode global attribute is an enumerated attribute that hints at the
+// type of data that might be entered by the user while editing the element or its
+// contents
+// This allows a browser to display an appropriate virtual keyboard
+// It is used primarily on <input> elements, but is usable on any element in
+// contenteditable mode
+// It's important to understand that the inputmode attribute doesn't cause any
+// validity requirements to be enforced on input
+// To require that input conforms to a particular data type, choose an appropriate
+// <input> element type
+// For specific guidance on choosing <input> types, see the Values section.
+// Remove the attribute INPUTMODE from the element.
+func (e *SEARCHElement) INPUTMODERemove(c SearchInputmodeChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("inputmode")
+	return e
+}
+
+// The is global attribute allows you to specify that a standard HTML element
+// should behave like a defined custom built-in element (see Using custom elements
+// for more details)
+// This attribute can only be used if the specified custom element name has been
+// successfully defined in the current document, and extends the element type it
+// is being applied to.
+func (e *SEARCHElement) IS(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("is", s)
+	return e
+}
+
+// The is global attribute allows you to specify that a standard HTML element
+// should behave like a defined custom built-in element (see Using custom elements
+// for more details)
+// This attribute can only be used if the specified custom element name has been
+// successfully defined in the current document, and extends the element type it
+// is being applied to.
+func (e *SEARCHElement) ISF(format string, args ...any) *SEARCHElement {
+	return e.IS(fmt.Sprintf(format, args...))
+}
+
+// The is global attribute allows you to specify that a standard HTML element
+// should behave like a defined custom built-in element (see Using custom elements
+// for more details)
+// This attribute can only be used if the specified custom element name has been
+// successfully defined in the current document, and extends the element type it
+// is being applied to.
+func (e *SEARCHElement) IfIS(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.IS(s)
+	}
+	return e
+}
+
+// The is global attribute allows you to specify that a standard HTML element
+// should behave like a defined custom built-in element (see Using custom elements
+// for more details)
+// This attribute can only be used if the specified custom element name has been
+// successfully defined in the current document, and extends the element type it
+// is being applied to.
+func (e *SEARCHElement) IfISF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.IS(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The is global attribute allows you to specify that a standard HTML element
+// should behave like a defined custom built-in element (see Using custom elements
+// for more details)
+// This attribute can only be used if the specified custom element name has been
+// successfully defined in the current document, and extends the element type it
+// is being applied to.
+// Remove the attribute IS from the element.
+func (e *SEARCHElement) ISRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("is")
+	return e
+}
+
+// The is global attribute allows you to specify that a standard HTML element
+// should behave like a defined custom built-in element (see Using custom elements
+// for more details)
+// This attribute can only be used if the specified custom element name has been
+// successfully defined in the current document, and extends the element type it
+// is being applied to.
+func (e *SEARCHElement) ISRemoveF(format string, args ...any) *SEARCHElement {
+	return e.ISRemove(fmt.Sprintf(format, args...))
+}
+
+// The itemid global attribute provides microdata in the form of a unique, global
+// identifier of an item.
+//
+//	An itemid attribute can only be specified for an element that has both
+//
+// itemscope and itemtype attributes
+// Also, itemid can only be specified on elements that possess an itemscope
+// attribute whose corresponding itemtype refers to or defines a vocabulary that
+// supports global identifiers
+// The exact meaning of an itemtype's global identifier is provided by the
+// definition of that identifier within the specified vocabulary
+// The vocabulary defines whether several items with the same global identifier
+// can coexist and, if so, how items with the same identifier are handled.
+func (e *SEARCHElement) ITEMID(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("itemid", s)
+	return e
+}
+
+// The itemid global attribute provides microdata in the form of a unique, global
+// identifier of an item.
+//
+//	An itemid attribute can only be specified for an element that has both
+//
+// itemscope and itemtype attributes
+// Also, itemid can only be specified on elements that possess an itemscope
+// attribute whose corresponding itemtype refers to or defines a vocabulary that
+// supports global identifiers
+// The exact meaning of an itemtype's global identifier is provided by the
+// definition of that identifier within the specified vocabulary
+// The vocabulary defines whether several items with the same global identifier
+// can coexist and, if so, how items with the same identifier are handled.
+func (e *SEARCHElement) ITEMIDF(format string, args ...any) *SEARCHElement {
+	return e.ITEMID(fmt.Sprintf(format, args...))
+}
+
+// The itemid global attribute provides microdata in the form of a unique, global
+// identifier of an item.
+//
+//	An itemid attribute can only be specified for an element that has both
+//
+// itemscope and itemtype attributes
+// Also, itemid can only be specified on elements that possess an itemscope
+// attribute whose corresponding itemtype refers to or defines a vocabulary that
+// supports global identifiers
+// The exact meaning of an itemtype's global identifier is provided by the
+// definition of that identifier within the specified vocabulary
+// The vocabulary defines whether several items with the same global identifier
+// can coexist and, if so, how items with the same identifier are handled.
+func (e *SEARCHElement) IfITEMID(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ITEMID(s)
+	}
+	return e
+}
+
+// The itemid global attribute provides microdata in the form of a unique, global
+// identifier of an item.
+//
+//	An itemid attribute can only be specified for an element that has both
+//
+// itemscope and itemtype attributes
+// Also, itemid can only be specified on elements that possess an itemscope
+// attribute whose corresponding itemtype refers to or defines a vocabulary that
+// supports global identifiers
+// The exact meaning of an itemtype's global identifier is provided by the
+// definition of that identifier within the specified vocabulary
+// The vocabulary defines whether several items with the same global identifier
+// can coexist and, if so, how items with the same identifier are handled.
+func (e *SEARCHElement) IfITEMIDF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ITEMID(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The itemid global attribute provides microdata in the form of a unique, global
+// identifier of an item.
+//
+//	An itemid attribute can only be specified for an element that has both
+//
+// itemscope and itemtype attributes
+// Also, itemid can only be specified on elements that possess an itemscope
+// attribute whose corresponding itemtype refers to or defines a vocabulary that
+// supports global identifiers
+// The exact meaning of an itemtype's global identifier is provided by the
+// definition of that identifier within the specified vocabulary
+// The vocabulary defines whether several items with the same global identifier
+// can coexist and, if so, how items with the same identifier are handled.
+// Remove the attribute ITEMID from the element.
+func (e *SEARCHElement) ITEMIDRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("itemid")
+	return e
+}
+
+// The itemid global attribute provides microdata in the form of a unique, global
+// identifier of an item.
+//
+//	An itemid attribute can only be specified for an element that has both
+//
+// itemscope and itemtype attributes
+// Also, itemid can only be specified on elements that possess an itemscope
+// attribute whose corresponding itemtype refers to or defines a vocabulary that
+// supports global identifiers
+// The exact meaning of an itemtype's global identifier is provided by the
+// definition of that identifier within the specified vocabulary
+// The vocabulary defines whether several items with the same global identifier
+// can coexist and, if so, how items with the same identifier are handled.
+func (e *SEARCHElement) ITEMIDRemoveF(format string, args ...any) *SEARCHElement {
+	return e.ITEMIDRemove(fmt.Sprintf(format, args...))
+}
+
+// The itemprop global attribute is used to add properties to an item
+// Every HTML element can have an itemprop attribute specified, and an itemprop
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMPROP(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("itemprop", s)
+	return e
+}
+
+// The itemprop global attribute is used to add properties to an item
+// Every HTML element can have an itemprop attribute specified, and an itemprop
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMPROPF(format string, args ...any) *SEARCHElement {
+	return e.ITEMPROP(fmt.Sprintf(format, args...))
+}
+
+// The itemprop global attribute is used to add properties to an item
+// Every HTML element can have an itemprop attribute specified, and an itemprop
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) IfITEMPROP(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ITEMPROP(s)
+	}
+	return e
+}
+
+// The itemprop global attribute is used to add properties to an item
+// Every HTML element can have an itemprop attribute specified, and an itemprop
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) IfITEMPROPF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ITEMPROP(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The itemprop global attribute is used to add properties to an item
+// Every HTML element can have an itemprop attribute specified, and an itemprop
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+// Remove the attribute ITEMPROP from the element.
+func (e *SEARCHElement) ITEMPROPRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("itemprop")
+	return e
+}
+
+// The itemprop global attribute is used to add properties to an item
+// Every HTML element can have an itemprop attribute specified, and an itemprop
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMPROPRemoveF(format string, args ...any) *SEARCHElement {
+	return e.ITEMPROPRemove(fmt.Sprintf(format, args...))
+}
+
+// Properties that are not descendants of an element with the itemscope attribute
+// can be associated with an item using the global attribute itemref
+// itemref provides a list of element IDs (not itemids) elsewhere in the document,
+// with additional properties The itemref attribute can only be specified on
+// elements that have an itemscope attribute specified.
+func (e *SEARCHElement) ITEMREF(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("itemref", s)
+	return e
+}
+
+// Properties that are not descendants of an element with the itemscope attribute
+// can be associated with an item using the global attribute itemref
+// itemref provides a list of element IDs (not itemids) elsewhere in the document,
+// with additional properties The itemref attribute can only be specified on
+// elements that have an itemscope attribute specified.
+func (e *SEARCHElement) ITEMREFF(format string, args ...any) *SEARCHElement {
+	return e.ITEMREF(fmt.Sprintf(format, args...))
+}
+
+// Properties that are not descendants of an element with the itemscope attribute
+// can be associated with an item using the global attribute itemref
+// itemref provides a list of element IDs (not itemids) elsewhere in the document,
+// with additional properties The itemref attribute can only be specified on
+// elements that have an itemscope attribute specified.
+func (e *SEARCHElement) IfITEMREF(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ITEMREF(s)
+	}
+	return e
+}
+
+// Properties that are not descendants of an element with the itemscope attribute
+// can be associated with an item using the global attribute itemref
+// itemref provides a list of element IDs (not itemids) elsewhere in the document,
+// with additional properties The itemref attribute can only be specified on
+// elements that have an itemscope attribute specified.
+func (e *SEARCHElement) IfITEMREFF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ITEMREF(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// Properties that are not descendants of an element with the itemscope attribute
+// can be associated with an item using the global attribute itemref
+// itemref provides a list of element IDs (not itemids) elsewhere in the document,
+// with additional properties The itemref attribute can only be specified on
+// elements that have an itemscope attribute specified.
+// Remove the attribute ITEMREF from the element.
+func (e *SEARCHElement) ITEMREFRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("itemref")
+	return e
+}
+
+// Properties that are not descendants of an element with the itemscope attribute
+// can be associated with an item using the global attribute itemref
+// itemref provides a list of element IDs (not itemids) elsewhere in the document,
+// with additional properties The itemref attribute can only be specified on
+// elements that have an itemscope attribute specified.
+func (e *SEARCHElement) ITEMREFRemoveF(format string, args ...any) *SEARCHElement {
+	return e.ITEMREFRemove(fmt.Sprintf(format, args...))
+}
+
+// The itemscope global attribute is used to add an item to a microdata DOM tree
+// Every HTML element can have an itemscope attribute specified, and an itemscope
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMSCOPE() *SEARCHElement {
+	e.ITEMSCOPESet(true)
+	return e
+}
+
+// The itemscope global attribute is used to add an item to a microdata DOM tree
+// Every HTML element can have an itemscope attribute specified, and an itemscope
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) IfITEMSCOPE(condition bool) *SEARCHElement {
+	if condition {
+		e.ITEMSCOPESet(true)
+	}
+	return e
+}
+
+// The itemscope global attribute is used to add an item to a microdata DOM tree
+// Every HTML element can have an itemscope attribute specified, and an itemscope
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+// Set the attribute ITEMSCOPE to the value b explicitly.
+func (e *SEARCHElement) ITEMSCOPESet(b bool) *SEARCHElement {
+	if e.BoolAttributes == nil {
+		e.BoolAttributes = treemap.New[string, bool]()
+	}
+	e.BoolAttributes.Set("itemscope", b)
+	return e
+}
+
+// The itemscope global attribute is used to add an item to a microdata DOM tree
+// Every HTML element can have an itemscope attribute specified, and an itemscope
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) IfSetITEMSCOPE(condition bool, b bool) *SEARCHElement {
+	if condition {
+		e.ITEMSCOPESet(b)
+	}
+	return e
+}
+
+// Remove the attribute ITEMSCOPE from the element.
+// The itemscope global attribute is used to add an item to a microdata DOM tree
+// Every HTML element can have an itemscope attribute specified, and an itemscope
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMSCOPERemove(b bool) *SEARCHElement {
+	if e.BoolAttributes == nil {
+		return e
+	}
+	e.BoolAttributes.Del("itemscope")
+	return e
+}
+
+// The itemtype global attribute is used to add types to an item
+// Every HTML element can have an itemtype attribute specified, and an itemtype
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMTYPE(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("itemtype", s)
+	return e
+}
+
+// The itemtype global attribute is used to add types to an item
+// Every HTML element can have an itemtype attribute specified, and an itemtype
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMTYPEF(format string, args ...any) *SEARCHElement {
+	return e.ITEMTYPE(fmt.Sprintf(format, args...))
+}
+
+// The itemtype global attribute is used to add types to an item
+// Every HTML element can have an itemtype attribute specified, and an itemtype
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) IfITEMTYPE(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ITEMTYPE(s)
+	}
+	return e
+}
+
+// The itemtype global attribute is used to add types to an item
+// Every HTML element can have an itemtype attribute specified, and an itemtype
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) IfITEMTYPEF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ITEMTYPE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The itemtype global attribute is used to add types to an item
+// Every HTML element can have an itemtype attribute specified, and an itemtype
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+// Remove the attribute ITEMTYPE from the element.
+func (e *SEARCHElement) ITEMTYPERemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("itemtype")
+	return e
+}
+
+// The itemtype global attribute is used to add types to an item
+// Every HTML element can have an itemtype attribute specified, and an itemtype
+// consists of a name-value pair
+// Each name-value pair is called a property, and a group of one or more
+// properties forms an item
+// Property values are either a string or a URL and can be associated with a very
+// wide range of elements including <audio>, <embed>, <iframe>, <img>, <link>,
+// <object>, <source>, <track>, and <video>.
+func (e *SEARCHElement) ITEMTYPERemoveF(format string, args ...any) *SEARCHElement {
+	return e.ITEMTYPERemove(fmt.Sprintf(format, args...))
+}
+
+// The lang global attribute helps define the language of an element: the language
+// that non-editable elements are written in or the language that editable
+// elements should be written in by the user
+// The tag contains one single entry value in the format defines in the Tags for
+// Identifying Languages (BCP47) IETF document
+// xml:lang has priority over it.
+func (e *SEARCHElement) LANG(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("lang", s)
+	return e
+}
+
+// The lang global attribute helps define the language of an element: the language
+// that non-editable elements are written in or the language that editable
+// elements should be written in by the user
+// The tag contains one single entry value in the format defines in the Tags for
+// Identifying Languages (BCP47) IETF document
+// xml:lang has priority over it.
+func (e *SEARCHElement) LANGF(format string, args ...any) *SEARCHElement {
+	return e.LANG(fmt.Sprintf(format, args...))
+}
+
+// The lang global attribute helps define the language of an element: the language
+// that non-editable elements are written in or the language that editable
+// elements should be written in by the user
+// The tag contains one single entry value in the format defines in the Tags for
+// Identifying Languages (BCP47) IETF document
+// xml:lang has priority over it.
+func (e *SEARCHElement) IfLANG(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.LANG(s)
+	}
+	return e
+}
+
+// The lang global attribute helps define the language of an element: the language
+// that non-editable elements are written in or the language that editable
+// elements should be written in by the user
+// The tag contains one single entry value in the format defines in the Tags for
+// Identifying Languages (BCP47) IETF document
+// xml:lang has priority over it.
+func (e *SEARCHElement) IfLANGF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.LANG(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The lang global attribute helps define the language of an element: the language
+// that non-editable elements are written in or the language that editable
+// elements should be written in by the user
+// The tag contains one single entry value in the format defines in the Tags for
+// Identifying Languages (BCP47) IETF document
+// xml:lang has priority over it.
+// Remove the attribute LANG from the element.
+func (e *SEARCHElement) LANGRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("lang")
+	return e
+}
+
+// The lang global attribute helps define the language of an element: the language
+// that non-editable elements are written in or the language that editable
+// elements should be written in by the user
+// The tag contains one single entry value in the format defines in the Tags for
+// Identifying Languages (BCP47) IETF document
+// xml:lang has priority over it.
+func (e *SEARCHElement) LANGRemoveF(format string, args ...any) *SEARCHElement {
+	return e.LANGRemove(fmt.Sprintf(format, args...))
+}
+
+// The nonce global attribute is a unique identifier used to declare inline
+// scripts and style elements to be used in a specific document
+// It is a cryptographic nonce (number used once) that is used by Content Security
+// Policy to determine whether or not a given inline script is allowed to execute.
+func (e *SEARCHElement) NONCE(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("nonce", s)
+	return e
+}
+
+// The nonce global attribute is a unique identifier used to declare inline
+// scripts and style elements to be used in a specific document
+// It is a cryptographic nonce (number used once) that is used by Content Security
+// Policy to determine whether or not a given inline script is allowed to execute.
+func (e *SEARCHElement) NONCEF(format string, args ...any) *SEARCHElement {
+	return e.NONCE(fmt.Sprintf(format, args...))
+}
+
+// The nonce global attribute is a unique identifier used to declare inline
+// scripts and style elements to be used in a specific document
+// It is a cryptographic nonce (number used once) that is used by Content Security
+// Policy to determine whether or not a given inline script is allowed to execute.
+func (e *SEARCHElement) IfNONCE(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.NONCE(s)
+	}
+	return e
+}
+
+// The nonce global attribute is a unique identifier used to declare inline
+// scripts and style elements to be used in a specific document
+// It is a cryptographic nonce (number used once) that is used by Content Security
+// Policy to determine whether or not a given inline script is allowed to execute.
+func (e *SEARCHElement) IfNONCEF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.NONCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The nonce global attribute is a unique identifier used to declare inline
+// scripts and style elements to be used in a specific document
+// It is a cryptographic nonce (number used once) that is used by Content Security
+// Policy to determine whether or not a given inline script is allowed to execute.
+// Remove the attribute NONCE from the element.
+func (e *SEARCHElement) NONCERemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("nonce")
+	return e
+}
+
+// The nonce global attribute is a unique identifier used to declare inline
+// scripts and style elements to be used in a specific document
+// It is a cryptographic nonce (number used once) that is used by Content Security
+// Policy to determine whether or not a given inline script is allowed to execute.
+func (e *SEARCHElement) NONCERemoveF(format string, args ...any) *SEARCHElement {
+	return e.NONCERemove(fmt.Sprintf(format, args...))
+}
+
+// The part global attribute contains a space-separated list of the part names of
+// the element
+// Part names allows CSS to select and style specific elements in a shadow tree
+// via the ::part pseudo-element.
+func (e *SEARCHElement) PART(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("part")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("part", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The part global attribute contains a space-separated list of the part names of
+// the element
+// Part names allows CSS to select and style specific elements in a shadow tree
+// via the ::part pseudo-element.
+func (e *SEARCHElement) IfPART(condition bool, s ...string) *SEARCHElement {
+	if condition {
+		e.PART(s...)
+	}
+	return e
+}
+
+// The part global attribute contains a space-separated list of the part names of
+// the element
+// Part names allows CSS to select and style specific elements in a shadow tree
+// via the ::part pseudo-element.
+// Remove the attribute PART from the element.
+func (e *SEARCHElement) PARTRemove(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("part")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The popover global attribute is used to designate an element as a popover
+// element
+// Popover elements are hidden via display: none until opened via an
+// invoking/control element (i.e
+// a <button> or <input type="button"> with a popovertarget attribute) or a
+// HTMLElement.showPopover() call
+// When open, popover elements will appear above all other elements in the top
+// layer, and won't be influenced by parent elements' position or overflow
+// styling.
+func (e *SEARCHElement) POPOVER(c SearchPopoverChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("popover", string(c))
+	return e
+}
+
+type SearchPopoverChoice string
+
+const (
+	// Popovers that have the auto state can be "light dismissed" by selecting outside
+	// the popover area, and generally only allow one popover to be displayed
+	// on-screen at a time.
+	SearchPopover_auto SearchPopoverChoice = "auto"
+	// Popovers that have the auto state can be "light dismissed" by selecting outside
+	// the popover area, and generally only allow one popover to be displayed
+	// on-screen at a time.
+	SearchPopover_empty SearchPopoverChoice = ""
+	// manual popovers must always be explicitly hidden, but allow for use cases such
+	// as nested popovers in menus.
+	SearchPopover_manual SearchPopoverChoice = "manual"
+)
+
+// The popover global attribute is used to designate an element as a popover
+// element
+// Popover elements are hidden via display: none until opened via an
+// invoking/control element (i.e
+// a <button> or <input type="button"> with a popovertarget attribute) or a
+// HTMLElement.showPopover() call
+// When open, popover elements will appear above all other elements in the top
+// layer, and won't be influenced by parent elements' position or overflow
+// styling.
+// Remove the attribute POPOVER from the element.
+func (e *SEARCHElement) POPOVERRemove(c SearchPopoverChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("popover")
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *SEARCHElement) PROPERTY(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("property", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *SEARCHElement) IfPROPERTY(condition bool, s ...string) *SEARCHElement {
+	if condition {
+		e.PROPERTY(s...)
+	}
+	return e
+}
+
+// The property RDFa attribute relates the element's content or resource to one or
+// more predicates, as a space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute PROPERTY from the element.
+func (e *SEARCHElement) PROPERTYRemove(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("property")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *SEARCHElement) RESOURCE(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("resource", s)
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *SEARCHElement) RESOURCEF(format string, args ...any) *SEARCHElement {
+	return e.RESOURCE(fmt.Sprintf(format, args...))
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *SEARCHElement) IfRESOURCE(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.RESOURCE(s)
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *SEARCHElement) IfRESOURCEF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.RESOURCE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+// Remove the attribute RESOURCE from the element.
+func (e *SEARCHElement) RESOURCERemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("resource")
+	return e
+}
+
+// The resource RDFa attribute sets the subject for predicates expressed on the
+// element, as a CURIE or IRI, without navigating to it.
+func (e *SEARCHElement) RESOURCERemoveF(format string, args ...any) *SEARCHElement {
+	return e.RESOURCERemove(fmt.Sprintf(format, args...))
+}
+
+// The role global attribute is used to define the purpose or state of an element
+// to the browser, in order to facilitate assistive technology such as screen
+// readers
+// It is a simple string value that can be used to describe the role of an
+// element.
+func (e *SEARCHElement) ROLE(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("role", s)
+	return e
+}
+
+// The role global attribute is used to define the purpose or state of an element
+// to the browser, in order to facilitate assistive technology such as screen
+// readers
+// It is a simple string value that can be used to describe the role of an
+// element.
+func (e *SEARCHElement) ROLEF(format string, args ...any) *SEARCHElement {
+	return e.ROLE(fmt.Sprintf(format, args...))
+}
+
+// The role global attribute is used to define the purpose or state of an element
+// to the browser, in order to facilitate assistive technology such as screen
+// readers
+// It is a simple string value that can be used to describe the role of an
+// element.
+func (e *SEARCHElement) IfROLE(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.ROLE(s)
+	}
+	return e
+}
+
+// The role global attribute is used to define the purpose or state of an element
+// to the browser, in order to facilitate assistive technology such as screen
+// readers
+// It is a simple string value that can be used to describe the role of an
+// element.
+func (e *SEARCHElement) IfROLEF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.ROLE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The role global attribute is used to define the purpose or state of an element
+// to the browser, in order to facilitate assistive technology such as screen
+// readers
+// It is a simple string value that can be used to describe the role of an
+// element.
+// Remove the attribute ROLE from the element.
+func (e *SEARCHElement) ROLERemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("role")
+	return e
+}
+
+// The role global attribute is used to define the purpose or state of an element
+// to the browser, in order to facilitate assistive technology such as screen
+// readers
+// It is a simple string value that can be used to describe the role of an
+// element.
+func (e *SEARCHElement) ROLERemoveF(format string, args ...any) *SEARCHElement {
+	return e.ROLERemove(fmt.Sprintf(format, args...))
+}
+
+// The slot global attribute assigns a slot in a shadow DOM shadow tree to an
+// element: An element with a slot attribute is assigned to the slot created by
+// the <slot> element whose name attribute's value matches that slot attribute's
+// value.
+func (e *SEARCHElement) SLOT(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("slot", s)
+	return e
+}
+
+// The slot global attribute assigns a slot in a shadow DOM shadow tree to an
+// element: An element with a slot attribute is assigned to the slot created by
+// the <slot> element whose name attribute's value matches that slot attribute's
+// value.
+func (e *SEARCHElement) SLOTF(format string, args ...any) *SEARCHElement {
+	return e.SLOT(fmt.Sprintf(format, args...))
+}
+
+// The slot global attribute assigns a slot in a shadow DOM shadow tree to an
+// element: An element with a slot attribute is assigned to the slot created by
+// the <slot> element whose name attribute's value matches that slot attribute's
+// value.
+func (e *SEARCHElement) IfSLOT(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.SLOT(s)
+	}
+	return e
+}
+
+// The slot global attribute assigns a slot in a shadow DOM shadow tree to an
+// element: An element with a slot attribute is assigned to the slot created by
+// the <slot> element whose name attribute's value matches that slot attribute's
+// value.
+func (e *SEARCHElement) IfSLOTF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.SLOT(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The slot global attribute assigns a slot in a shadow DOM shadow tree to an
+// element: An element with a slot attribute is assigned to the slot created by
+// the <slot> element whose name attribute's value matches that slot attribute's
+// value.
+// Remove the attribute SLOT from the element.
+func (e *SEARCHElement) SLOTRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("slot")
+	return e
+}
+
+// The slot global attribute assigns a slot in a shadow DOM shadow tree to an
+// element: An element with a slot attribute is assigned to the slot created by
+// the <slot> element whose name attribute's value matches that slot attribute's
+// value.
+func (e *SEARCHElement) SLOTRemoveF(format string, args ...any) *SEARCHElement {
+	return e.SLOTRemove(fmt.Sprintf(format, args...))
+}
+
+// The spellcheck global attribute is an enumerated attribute that defines whether
+// the element may be checked for spelling errors
+// If this attribute is not set, its default value is element-type and
+// browser-defined
+// This default value may also be inherited, which means that the element content
+// will be checked for spelling errors only if its nearest ancestor has a
+// spellcheck state of true
+// Security and privacy concerns Using spellchecking can have consequences for
+// users' security and privacy
+// The specification does not regulate how spellchecking is done and the content
+// of the element may be sent to a third party for spellchecking results (see
+// enhanced spellchecking and "spell-jacking")
+// You should consider setting spellcheck to false for elements that can contain
+// sensitive information.
+func (e *SEARCHElement) SPELLCHECK(c SearchSpellcheckChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("spellcheck", string(c))
+	return e
+}
+
+type SearchSpellcheckChoice string
+
+const (
+	// The element will be checked for spelling errors.
+	SearchSpellcheck_empty SearchSpellcheckChoice = ""
+	// The element will be checked for spelling errors.
+	SearchSpellcheck_true SearchSpellcheckChoice = "true"
+	// The element will not be checked for spelling errors.
+	SearchSpellcheck_false SearchSpellcheckChoice = "false"
+)
+
+// The spellcheck global attribute is an enumerated attribute that defines whether
+// the element may be checked for spelling errors
+// If this attribute is not set, its default value is element-type and
+// browser-defined
+// This default value may also be inherited, which means that the element content
+// will be checked for spelling errors only if its nearest ancestor has a
+// spellcheck state of true
+// Security and privacy concerns Using spellchecking can have consequences for
+// users' security and privacy
+// The specification does not regulate how spellchecking is done and the content
+// of the element may be sent to a third party for spellchecking results (see
+// enhanced spellchecking and "spell-jacking")
+// You should consider setting spellcheck to false for elements that can contain
+// sensitive information.
+// Remove the attribute SPELLCHECK from the element.
+func (e *SEARCHElement) SPELLCHECKRemove(c SearchSpellcheckChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("spellcheck")
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+func (e *SEARCHElement) STYLEF(k string, format string, args ...any) *SEARCHElement {
+	return e.STYLE(k, fmt.Sprintf(format, args...))
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+func (e *SEARCHElement) IfSTYLE(condition bool, k string, v string) *SEARCHElement {
+	if condition {
+		e.STYLE(k, v)
+	}
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+func (e *SEARCHElement) STYLE(k string, v string) *SEARCHElement {
+	if e.KVStrings == nil {
+		e.KVStrings = treemap.New[string, *KVBuilder]()
+	}
+	kv, ok := e.KVStrings.Get("style")
+	if !ok {
+		kv = NewKVBuilder(":", ";")
+		e.KVStrings.Set("style", kv)
+	}
+	kv.Add(k, v)
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+func (e *SEARCHElement) IfSTYLEF(condition bool, k string, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.STYLE(k, fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+// Add the attributes in the map to the element.
+func (e *SEARCHElement) STYLEMap(m map[string]string) *SEARCHElement {
+	if e.KVStrings == nil {
+		e.KVStrings = treemap.New[string, *KVBuilder]()
+	}
+	kv, ok := e.KVStrings.Get("style")
+	if !ok {
+		kv = NewKVBuilder(":", ";")
+		e.KVStrings.Set("style", kv)
+	}
+	for k, v := range m {
+		kv.Add(k, v)
+	}
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+// Add pairs of attributes to the element.
+func (e *SEARCHElement) STYLEPairs(pairs ...string) *SEARCHElement {
+	if len(pairs)%2 != 0 {
+		panic("Must have an even number of pairs")
+	}
+	if e.KVStrings == nil {
+		e.KVStrings = treemap.New[string, *KVBuilder]()
+	}
+	kv, ok := e.KVStrings.Get("style")
+	if !ok {
+		kv = NewKVBuilder(":", ";")
+		e.KVStrings.Set("style", kv)
+	}
+
+	for i := 0; i < len(pairs); i += 2 {
+		kv.Add(pairs[i], pairs[i+1])
+	}
+
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+func (e *SEARCHElement) IfSTYLEPairs(condition bool, pairs ...string) *SEARCHElement {
+	if condition {
+		e.STYLEPairs(pairs...)
+	}
+	return e
+}
+
+// The style global attribute is used to add styles to an element, such as color,
+// font, size, and more
+// Styles are written in CSS.
+// Remove the attribute STYLE from the element.
+func (e *SEARCHElement) STYLERemove(keys ...string) *SEARCHElement {
+	if e.KVStrings == nil {
+		return e
+	}
+	kv, ok := e.KVStrings.Get("style")
+	if !ok {
+		return e
+	}
+	for _, k := range keys {
+		kv.Remove(k)
+	}
+	return e
+}
+
+// The tabindex global attribute indicates if its element can be focused, and
+// if/where it participates in sequential keyboard navigation (usually with the
+// Tab key, hence the name)
+// It accepts an integer as a value, with different results depending on the
+// integer's value: a negative value (usually tabindex="-1") means that the
+// element should be focusable, but should not be reachable via sequential
+// keyboard navigation; a value of 0 (tabindex="0") means that the element should
+// be focusable and reachable via sequential keyboard navigation, but its relative
+// order is defined by the platform convention; a positive value means should be
+// focusable and reachable via sequential keyboard navigation; its relative order
+// is defined by the value of the attribute: the sequential follow the increasing
+// number of the tabindex
+// If several elements share the same tabindex, their relative order follows their
+// relative position in the document.
+func (e *SEARCHElement) TABINDEX(i int) *SEARCHElement {
+	if e.IntAttributes == nil {
+		e.IntAttributes = treemap.New[string, int]()
+	}
+	e.IntAttributes.Set("tabindex", i)
+	return e
+}
+
+// The tabindex global attribute indicates if its element can be focused, and
+// if/where it participates in sequential keyboard navigation (usually with the
+// Tab key, hence the name)
+// It accepts an integer as a value, with different results depending on the
+// integer's value: a negative value (usually tabindex="-1") means that the
+// element should be focusable, but should not be reachable via sequential
+// keyboard navigation; a value of 0 (tabindex="0") means that the element should
+// be focusable and reachable via sequential keyboard navigation, but its relative
+// order is defined by the platform convention; a positive value means should be
+// focusable and reachable via sequential keyboard navigation; its relative order
+// is defined by the value of the attribute: the sequential follow the increasing
+// number of the tabindex
+// If several elements share the same tabindex, their relative order follows their
+// relative position in the document.
+func (e *SEARCHElement) IfTABINDEX(condition bool, i int) *SEARCHElement {
+	if condition {
+		e.TABINDEX(i)
+	}
+	return e
+}
+
+// The tabindex global attribute indicates if its element can be focused, and
+// if/where it participates in sequential keyboard navigation (usually with the
+// Tab key, hence the name)
+// It accepts an integer as a value, with different results depending on the
+// integer's value: a negative value (usually tabindex="-1") means that the
+// element should be focusable, but should not be reachable via sequential
+// keyboard navigation; a value of 0 (tabindex="0") means that the element should
+// be focusable and reachable via sequential keyboard navigation, but its relative
+// order is defined by the platform convention; a positive value means should be
+// focusable and reachable via sequential keyboard navigation; its relative order
+// is defined by the value of the attribute: the sequential follow the increasing
+// number of the tabindex
+// If several elements share the same tabindex, their relative order follows their
+// relative position in the document.
+// Remove the attribute TABINDEX from the element.
+func (e *SEARCHElement) TABINDEXRemove(i int) *SEARCHElement {
+	if e.IntAttributes == nil {
+		return e
+	}
+	e.IntAttributes.Del("tabindex")
+	return e
+}
+
+// The title global attribute contains text representing advisory information
+// related to the element it belongs to
+// Such information can typically, but not necessarily, be presented to the user
+// as a tooltip
+// The main use of the title attribute is to label <iframe> elements for assistive
+// technology
+// The title attribute may also be used to label controls in data tables
+// The title attribute, when added to <link rel="stylesheet">, creates an
+// alternate stylesheet
+// When defining an alternative style sheet with <link rel="alternate"> the
+// attribute is required and must be set to a non-empty string
+// If included on the <abbr> opening tag, the title must be a full expansion of
+// the abbreviation or acronym
+// Instead of using title, when possible, provide an expansion of the abbreviation
+// or acronym in plain text on first use, using the <abbr> to mark up the
+// abbreviation
+// This enables all users know what name or term the abbreviation or acronym
+// shortens while providing a hint to user agents on how to announce the content
+// While title can be used to provide a programmatically associated label for an
+// <input> element, this is not good practice
+// Use a <label> instead.
+func (e *SEARCHElement) TITLE(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("title", s)
+	return e
+}
+
+// The title global attribute contains text representing advisory information
+// related to the element it belongs to
+// Such information can typically, but not necessarily, be presented to the user
+// as a tooltip
+// The main use of the title attribute is to label <iframe> elements for assistive
+// technology
+// The title attribute may also be used to label controls in data tables
+// The title attribute, when added to <link rel="stylesheet">, creates an
+// alternate stylesheet
+// When defining an alternative style sheet with <link rel="alternate"> the
+// attribute is required and must be set to a non-empty string
+// If included on the <abbr> opening tag, the title must be a full expansion of
+// the abbreviation or acronym
+// Instead of using title, when possible, provide an expansion of the abbreviation
+// or acronym in plain text on first use, using the <abbr> to mark up the
+// abbreviation
+// This enables all users know what name or term the abbreviation or acronym
+// shortens while providing a hint to user agents on how to announce the content
+// While title can be used to provide a programmatically associated label for an
+// <input> element, this is not good practice
+// Use a <label> instead.
+func (e *SEARCHElement) TITLEF(format string, args ...any) *SEARCHElement {
+	return e.TITLE(fmt.Sprintf(format, args...))
+}
+
+// The title global attribute contains text representing advisory information
+// related to the element it belongs to
+// Such information can typically, but not necessarily, be presented to the user
+// as a tooltip
+// The main use of the title attribute is to label <iframe> elements for assistive
+// technology
+// The title attribute may also be used to label controls in data tables
+// The title attribute, when added to <link rel="stylesheet">, creates an
+// alternate stylesheet
+// When defining an alternative style sheet with <link rel="alternate"> the
+// attribute is required and must be set to a non-empty string
+// If included on the <abbr> opening tag, the title must be a full expansion of
+// the abbreviation or acronym
+// Instead of using title, when possible, provide an expansion of the abbreviation
+// or acronym in plain text on first use, using the <abbr> to mark up the
+// abbreviation
+// This enables all users know what name or term the abbreviation or acronym
+// shortens while providing a hint to user agents on how to announce the content
+// While title can be used to provide a programmatically associated label for an
+// <input> element, this is not good practice
+// Use a <label> instead.
+func (e *SEARCHElement) IfTITLE(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.TITLE(s)
+	}
+	return e
+}
+
+// The title global attribute contains text representing advisory information
+// related to the element it belongs to
+// Such information can typically, but not necessarily, be presented to the user
+// as a tooltip
+// The main use of the title attribute is to label <iframe> elements for assistive
+// technology
+// The title attribute may also be used to label controls in data tables
+// The title attribute, when added to <link rel="stylesheet">, creates an
+// alternate stylesheet
+// When defining an alternative style sheet with <link rel="alternate"> the
+// attribute is required and must be set to a non-empty string
+// If included on the <abbr> opening tag, the title must be a full expansion of
+// the abbreviation or acronym
+// Instead of using title, when possible, provide an expansion of the abbreviation
+// or acronym in plain text on first use, using the <abbr> to mark up the
+// abbreviation
+// This enables all users know what name or term the abbreviation or acronym
+// shortens while providing a hint to user agents on how to announce the content
+// While title can be used to provide a programmatically associated label for an
+// <input> element, this is not good practice
+// Use a <label> instead.
+func (e *SEARCHElement) IfTITLEF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.TITLE(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The title global attribute contains text representing advisory information
+// related to the element it belongs to
+// Such information can typically, but not necessarily, be presented to the user
+// as a tooltip
+// The main use of the title attribute is to label <iframe> elements for assistive
+// technology
+// The title attribute may also be used to label controls in data tables
+// The title attribute, when added to <link rel="stylesheet">, creates an
+// alternate stylesheet
+// When defining an alternative style sheet with <link rel="alternate"> the
+// attribute is required and must be set to a non-empty string
+// If included on the <abbr> opening tag, the title must be a full expansion of
+// the abbreviation or acronym
+// Instead of using title, when possible, provide an expansion of the abbreviation
+// or acronym in plain text on first use, using the <abbr> to mark up the
+// abbreviation
+// This enables all users know what name or term the abbreviation or acronym
+// shortens while providing a hint to user agents on how to announce the content
+// While title can be used to provide a programmatically associated label for an
+// <input> element, this is not good practice
+// Use a <label> instead.
+// Remove the attribute TITLE from the element.
+func (e *SEARCHElement) TITLERemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("title")
+	return e
+}
+
+// The title global attribute contains text representing advisory information
+// related to the element it belongs to
+// Such information can typically, but not necessarily, be presented to the user
+// as a tooltip
+// The main use of the title attribute is to label <iframe> elements for assistive
+// technology
+// The title attribute may also be used to label controls in data tables
+// The title attribute, when added to <link rel="stylesheet">, creates an
+// alternate stylesheet
+// When defining an alternative style sheet with <link rel="alternate"> the
+// attribute is required and must be set to a non-empty string
+// If included on the <abbr> opening tag, the title must be a full expansion of
+// the abbreviation or acronym
+// Instead of using title, when possible, provide an expansion of the abbreviation
+// or acronym in plain text on first use, using the <abbr> to mark up the
+// abbreviation
+// This enables all users know what name or term the abbreviation or acronym
+// shortens while providing a hint to user agents on how to announce the content
+// While title can be used to provide a programmatically associated label for an
+// <input> element, this is not good practice
+// Use a <label> instead.
+func (e *SEARCHElement) TITLERemoveF(format string, args ...any) *SEARCHElement {
+	return e.TITLERemove(fmt.Sprintf(format, args...))
+}
+
+// The translate global attribute is an enumerated attribute that is used to
+// specify whether an element's attribute values and the values of its Text node
+// children are to be translated when the page is localized, or whether to leave
+// them unchanged.
+func (e *SEARCHElement) TRANSLATE(c SearchTranslateChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("translate", string(c))
+	return e
+}
+
+type SearchTranslateChoice string
+
+const (
+	// indicates that the element should be translated when the page is localized.
+	SearchTranslate_empty SearchTranslateChoice = ""
+	// indicates that the element should be translated when the page is localized.
+	SearchTranslate_yes SearchTranslateChoice = "yes"
+	// indicates that the element must not be translated when the page is localized.
+	SearchTranslate_no SearchTranslateChoice = "no"
+)
+
+// The translate global attribute is an enumerated attribute that is used to
+// specify whether an element's attribute values and the values of its Text node
+// children are to be translated when the page is localized, or whether to leave
+// them unchanged.
+// Remove the attribute TRANSLATE from the element.
+func (e *SEARCHElement) TRANSLATERemove(c SearchTranslateChoice) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("translate")
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *SEARCHElement) TYPEOF(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		e.DelimitedStrings = treemap.New[string, *DelimitedBuilder[string]]()
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		ds = NewDelimitedBuilder[string](" ")
+		e.DelimitedStrings.Set("typeof", ds)
+	}
+	ds.Add(s...)
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+func (e *SEARCHElement) IfTYPEOF(condition bool, s ...string) *SEARCHElement {
+	if condition {
+		e.TYPEOF(s...)
+	}
+	return e
+}
+
+// The typeof RDFa attribute lists the RDF types of the subject, as a
+// space-separated list of terms, CURIEs, or absolute IRIs.
+// Remove the attribute TYPEOF from the element.
+func (e *SEARCHElement) TYPEOFRemove(s ...string) *SEARCHElement {
+	if e.DelimitedStrings == nil {
+		return e
+	}
+	ds, ok := e.DelimitedStrings.Get("typeof")
+	if !ok {
+		return e
+	}
+	ds.Remove(s...)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *SEARCHElement) VOCAB(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+	e.StringAttributes.Set("vocab", s)
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *SEARCHElement) VOCABF(format string, args ...any) *SEARCHElement {
+	return e.VOCAB(fmt.Sprintf(format, args...))
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *SEARCHElement) IfVOCAB(condition bool, s string) *SEARCHElement {
+	if condition {
+		e.VOCAB(s)
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *SEARCHElement) IfVOCABF(condition bool, format string, args ...any) *SEARCHElement {
+	if condition {
+		e.VOCAB(fmt.Sprintf(format, args...))
+	}
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+// Remove the attribute VOCAB from the element.
+func (e *SEARCHElement) VOCABRemove(s string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+	e.StringAttributes.Del("vocab")
+	return e
+}
+
+// The vocab RDFa attribute sets the default vocabulary IRI used to resolve terms
+// in property and typeof attributes.
+func (e *SEARCHElement) VOCABRemoveF(format string, args ...any) *SEARCHElement {
+	return e.VOCABRemove(fmt.Sprintf(format, args...))
+}
+
+// Sets the value of any HTML attribute to an expression, and keeps it in sync.
+//
+// See: https://data-star.dev/reference/attributes#data-attr
+func (e *SEARCHElement) DATASTAR_ATTR(key string, expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-attr%s", suffix)
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Sets the value of any HTML attribute to an expression, and keeps it in sync.
+//
+// See: https://data-star.dev/reference/attributes#data-attr
+func (e *SEARCHElement) IfDATASTAR_ATTR(condition bool, key string, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_ATTR(key, expression)
+	}
+	return e
+}
+
+// Sets the value of any HTML attribute to an expression, and keeps it in sync.
+//
+// See: https://data-star.dev/reference/attributes#data-attr
+// Remove the attribute DATASTAR_ATTR from the element.
+func (e *SEARCHElement) DATASTAR_ATTRRemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-attr" + suffix)
+
+	return e
+}
+
+// Creates a signal (if one doesn’t already exist) and sets up two-way data
+// binding between it and an element’s value.
+//
+// See: https://data-star.dev/reference/attributes#data-bind
+func (e *SEARCHElement) DATASTAR_BIND(key string, expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-bind%s", suffix)
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Creates a signal (if one doesn’t already exist) and sets up two-way data
+// binding between it and an element’s value.
+//
+// See: https://data-star.dev/reference/attributes#data-bind
+func (e *SEARCHElement) IfDATASTAR_BIND(condition bool, key string, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_BIND(key, expression)
+	}
+	return e
+}
+
+// Creates a signal (if one doesn’t already exist) and sets up two-way data
+// binding between it and an element’s value.
+//
+// See: https://data-star.dev/reference/attributes#data-bind
+// Remove the attribute DATASTAR_BIND from the element.
+func (e *SEARCHElement) DATASTAR_BINDRemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-bind" + suffix)
+
+	return e
+}
+
+type SearchClassMod customDataKeyModifier
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchClassModCase(
+	s string,
+) SearchClassMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Adds or removes a class to or from an element based on an expression.
+func (e *SEARCHElement) DATASTAR_CLASS(key string, expression string, modifiers ...SearchClassMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-class%s", suffix)
+
+	customMods := lo.Map(modifiers, func(m SearchClassMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Adds or removes a class to or from an element based on an expression.
+func (e *SEARCHElement) IfDATASTAR_CLASS(condition bool, key string, expression string, modifiers ...SearchClassMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_CLASS(key, expression, modifiers...)
+	}
+	return e
+}
+
+// Adds or removes a class to or from an element based on an expression.
+// Remove the attribute DATASTAR_CLASS from the element.
+func (e *SEARCHElement) DATASTAR_CLASSRemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-class" + suffix)
+
+	return e
+}
+
+type SearchComputedMod customDataKeyModifier
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchComputedModCase(
+	s string,
+) SearchComputedMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Creates a signal that is computed based on an expression
+// The computed signal is read-only, and its value is automatically updated when
+// any signals in the expression are updated.
+//
+// See: https://data-star.dev/reference/attributes#data-computed
+func (e *SEARCHElement) DATASTAR_COMPUTED(key string, expression string, modifiers ...SearchComputedMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-computed%s", suffix)
+
+	customMods := lo.Map(modifiers, func(m SearchComputedMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Creates a signal that is computed based on an expression
+// The computed signal is read-only, and its value is automatically updated when
+// any signals in the expression are updated.
+//
+// See: https://data-star.dev/reference/attributes#data-computed
+func (e *SEARCHElement) IfDATASTAR_COMPUTED(condition bool, key string, expression string, modifiers ...SearchComputedMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_COMPUTED(key, expression, modifiers...)
+	}
+	return e
+}
+
+// Creates a signal that is computed based on an expression
+// The computed signal is read-only, and its value is automatically updated when
+// any signals in the expression are updated.
+//
+// See: https://data-star.dev/reference/attributes#data-computed
+// Remove the attribute DATASTAR_COMPUTED from the element.
+func (e *SEARCHElement) DATASTAR_COMPUTEDRemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-computed" + suffix)
+
+	return e
+}
+
+// Executes an expression on page load and whenever any signals in the expression
+// change
+// This is useful for performing side effects, such as updating other signals,
+// making requests to the backend, or manipulating the DOM.
+//
+// See: https://data-star.dev/reference/attributes#data-effect
+func (e *SEARCHElement) DATASTAR_EFFECT(expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-effect"
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Executes an expression on page load and whenever any signals in the expression
+// change
+// This is useful for performing side effects, such as updating other signals,
+// making requests to the backend, or manipulating the DOM.
+//
+// See: https://data-star.dev/reference/attributes#data-effect
+func (e *SEARCHElement) IfDATASTAR_EFFECT(condition bool, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_EFFECT(expression)
+	}
+	return e
+}
+
+// Executes an expression on page load and whenever any signals in the expression
+// change
+// This is useful for performing side effects, such as updating other signals,
+// making requests to the backend, or manipulating the DOM.
+//
+// See: https://data-star.dev/reference/attributes#data-effect
+// Remove the attribute DATASTAR_EFFECT from the element.
+func (e *SEARCHElement) DATASTAR_EFFECTRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-effect")
+
+	return e
+}
+
+type SearchIgnoreMod customDataKeyModifier
+
+// Only ignore the element itself, not its descendants.
+func SearchIgnoreModSelf() SearchIgnoreMod {
+	return func() string {
+		return "self"
+	}
+}
+
+// Datastar walks the entire DOM and applies plugins to each element it encounters
+// It's possible to tell Datastar to ignore an element and its descendants by
+// placing a data-ignore attribute on it
+// This can be useful for preventing naming conflicts with third-party libraries,
+// or when you are unable to escape user input.
+//
+// See: https://data-star.dev/reference/attributes#data-ignore
+func (e *SEARCHElement) DATASTAR_IGNORESet(b bool, modifiers ...SearchIgnoreMod) *SEARCHElement {
+	key := customDataKey("data-ignore")
+	customMods := lo.Map(modifiers, func(m SearchIgnoreMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	if e.BoolAttributes == nil {
+		e.BoolAttributes = treemap.New[string, bool]()
+	}
+	e.BoolAttributes.Set(key, b)
+	return e
+}
+
+// Datastar walks the entire DOM and applies plugins to each element it encounters
+// It's possible to tell Datastar to ignore an element and its descendants by
+// placing a data-ignore attribute on it
+// This can be useful for preventing naming conflicts with third-party libraries,
+// or when you are unable to escape user input.
+//
+// See: https://data-star.dev/reference/attributes#data-ignore
+func (e *SEARCHElement) DATASTAR_IGNORE(modifiers ...SearchIgnoreMod) *SEARCHElement {
+	return e.DATASTAR_IGNORESet(true, modifiers...)
+}
+
+// Similar to the data-ignore attribute, the data-ignore-morph attribute tells the
+// PatchElements watcher to skip processing an element and its children when
+// morphing elements
+// This can be useful for preventing conflicts with third-party libraries that
+// manipulate the DOM, or when you are unable to escape user input.
+//
+// See: https://data-star.dev/reference/attributes#data-ignore-morph
+func (e *SEARCHElement) DATASTAR_IGNORE_MORPHSet(b bool) *SEARCHElement {
+	key := "data-ignore-morph"
+	if e.BoolAttributes == nil {
+		e.BoolAttributes = treemap.New[string, bool]()
+	}
+	e.BoolAttributes.Set(key, b)
+	return e
+}
+
+// Similar to the data-ignore attribute, the data-ignore-morph attribute tells the
+// PatchElements watcher to skip processing an element and its children when
+// morphing elements
+// This can be useful for preventing conflicts with third-party libraries that
+// manipulate the DOM, or when you are unable to escape user input.
+//
+// See: https://data-star.dev/reference/attributes#data-ignore-morph
+func (e *SEARCHElement) DATASTAR_IGNORE_MORPH() *SEARCHElement {
+	return e.DATASTAR_IGNORE_MORPHSet(true)
+}
+
+type SearchIndicatorMod customDataKeyModifier
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchIndicatorModCase(
+	s string,
+) SearchIndicatorMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Creates a signal and sets its value to true while a fetch request is in flight,
+// otherwise false
+// The signal can be used to show a loading indicator.
+//
+// See: https://data-star.dev/reference/attributes#data-indicator
+func (e *SEARCHElement) DATASTAR_INDICATOR(expression string, modifiers ...SearchIndicatorMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-indicator"
+
+	customMods := lo.Map(modifiers, func(m SearchIndicatorMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Creates a signal and sets its value to true while a fetch request is in flight,
+// otherwise false
+// The signal can be used to show a loading indicator.
+//
+// See: https://data-star.dev/reference/attributes#data-indicator
+func (e *SEARCHElement) IfDATASTAR_INDICATOR(condition bool, expression string, modifiers ...SearchIndicatorMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_INDICATOR(expression, modifiers...)
+	}
+	return e
+}
+
+// Creates a signal and sets its value to true while a fetch request is in flight,
+// otherwise false
+// The signal can be used to show a loading indicator.
+//
+// See: https://data-star.dev/reference/attributes#data-indicator
+// Remove the attribute DATASTAR_INDICATOR from the element.
+func (e *SEARCHElement) DATASTAR_INDICATORRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-indicator")
+
+	return e
+}
+
+type SearchInitMod customDataKeyModifier
+
+// Delay the event listener in milliseconds.
+func SearchInitModDelayMs(
+	d time.Duration,
+) SearchInitMod {
+	return func() string {
+		return fmt.Sprintf("delay.%dms", d.Milliseconds())
+	}
+}
+
+// Delay the event listener in seconds.
+func SearchInitModDelaySec(
+	d time.Duration,
+) SearchInitMod {
+	return func() string {
+		return fmt.Sprintf("delay.%ds", int(d.Seconds()))
+	}
+}
+
+// Wraps the expression in 'document.startViewTransition()' when the View
+// Transition API is available.
+func SearchInitModViewTransition() SearchInitMod {
+	return func() string {
+		return "viewtransition"
+	}
+}
+
+// Runs an expression when the attribute is initialized
+// This can happen on page load, when an element is patched into the DOM, and any
+// time the attribute is modified (via a backend action or otherwise).
+//
+// See: https://data-star.dev/reference/attributes#data-indicator
+func (e *SEARCHElement) DATASTAR_INIT(expression string, modifiers ...SearchInitMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-init"
+
+	customMods := lo.Map(modifiers, func(m SearchInitMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Runs an expression when the attribute is initialized
+// This can happen on page load, when an element is patched into the DOM, and any
+// time the attribute is modified (via a backend action or otherwise).
+//
+// See: https://data-star.dev/reference/attributes#data-indicator
+func (e *SEARCHElement) IfDATASTAR_INIT(condition bool, expression string, modifiers ...SearchInitMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_INIT(expression, modifiers...)
+	}
+	return e
+}
+
+// Runs an expression when the attribute is initialized
+// This can happen on page load, when an element is patched into the DOM, and any
+// time the attribute is modified (via a backend action or otherwise).
+//
+// See: https://data-star.dev/reference/attributes#data-indicator
+// Remove the attribute DATASTAR_INIT from the element.
+func (e *SEARCHElement) DATASTAR_INITRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-init")
+
+	return e
+}
+
+type SearchJsonSignalsMod customDataKeyModifier
+
+// Outputs a more compact JSON format without extra whitespace
+// Useful for displaying filtered data inline.
+func SearchJsonSignalsModTerse() SearchJsonSignalsMod {
+	return func() string {
+		return "terse"
+	}
+}
+
+// Sets the text content of an element to a reactive JSON stringified version of
+// signals
+// Useful when troubleshooting an issue.
+//
+// See: https://data-star.dev/reference/attributes#data-json-signals
+func (e *SEARCHElement) DATASTAR_JSON_SIGNALS(expression string, modifiers ...SearchJsonSignalsMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-json-signals"
+
+	customMods := lo.Map(modifiers, func(m SearchJsonSignalsMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Sets the text content of an element to a reactive JSON stringified version of
+// signals
+// Useful when troubleshooting an issue.
+//
+// See: https://data-star.dev/reference/attributes#data-json-signals
+func (e *SEARCHElement) IfDATASTAR_JSON_SIGNALS(condition bool, expression string, modifiers ...SearchJsonSignalsMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_JSON_SIGNALS(expression, modifiers...)
+	}
+	return e
+}
+
+// Sets the text content of an element to a reactive JSON stringified version of
+// signals
+// Useful when troubleshooting an issue.
+//
+// See: https://data-star.dev/reference/attributes#data-json-signals
+// Remove the attribute DATASTAR_JSON_SIGNALS from the element.
+func (e *SEARCHElement) DATASTAR_JSON_SIGNALSRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-json-signals")
+
+	return e
+}
+
+type SearchOnMod customDataKeyModifier
+
+// Only run the expression once
+// Only works with built-in events.
+func SearchOnModOnce() SearchOnMod {
+	return func() string {
+		return "once"
+	}
+}
+
+// Do not call preventDefault on the event listener
+// Only works with built-in events.
+func SearchOnModPassive() SearchOnMod {
+	return func() string {
+		return "passive"
+	}
+}
+
+// Use capture event listener
+// Only works with built-in events.
+func SearchOnModCapture() SearchOnMod {
+	return func() string {
+		return "capture"
+	}
+}
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchOnModCase(
+	s string,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Delay the event listener in milliseconds.
+func SearchOnModDelayMs(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("delay.%dms", d.Milliseconds())
+	}
+}
+
+// Delay the event listener in seconds.
+func SearchOnModDelaySec(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("delay.%ds", int(d.Seconds()))
+	}
+}
+
+// Debounces the event handler
+func SearchOnModDebounceMs(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms", d.Milliseconds())
+	}
+}
+
+// Debounce the event listener in milliseconds with leading edge.
+func SearchOnModDebounceMsLeading(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms.leading", d.Milliseconds())
+	}
+}
+
+// Debounce the event listener in milliseconds without trailing edge.
+func SearchOnModDebounceMsNoTrailing(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms.notrailing", d.Milliseconds())
+	}
+}
+
+// Debounces the event handler
+func SearchOnModDebounceSec(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds", int(d.Seconds()))
+	}
+}
+
+// Debounce the event listener in seconds with leading edge.
+func SearchOnModDebounceSecLeading(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds.leading", int(d.Seconds()))
+	}
+}
+
+// Debounce the event listener in seconds without trailing edge.
+func SearchOnModDebounceSecNoTrailing(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds.notrailing", int(d.Seconds()))
+	}
+}
+
+// Throttles the event handler
+func SearchOnModThrottleMs(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms", d.Milliseconds())
+	}
+}
+
+// Throttle the event listener in milliseconds without leading edge.
+func SearchOnModThrottleMsNoLeading(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms.noleading", d.Milliseconds())
+	}
+}
+
+// Throttle the event listener in milliseconds with trailing edge.
+func SearchOnModThrottleMsTrailing(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms.trailing", d.Milliseconds())
+	}
+}
+
+// Throttles the event listener in seconds.
+func SearchOnModThrottleSec(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds", int(d.Seconds()))
+	}
+}
+
+// Throttle the event listener in seconds without leading edge.
+func SearchOnModThrottleSecNoLeading(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds.noleading", int(d.Seconds()))
+	}
+}
+
+// Throttle the event listener in seconds with trailing edge.
+func SearchOnModThrottleSecTrailing(
+	d time.Duration,
+) SearchOnMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds.trailing", int(d.Seconds()))
+	}
+}
+
+// Wraps the expression in 'document.startViewTransition()' when the View
+// Transition API is available.
+func SearchOnModViewTransition() SearchOnMod {
+	return func() string {
+		return "viewtransition"
+	}
+}
+
+// Attaches the event listener to the 'window' element.
+func SearchOnModWindow() SearchOnMod {
+	return func() string {
+		return "window"
+	}
+}
+
+// Calls 'preventDefault' on the event listener.
+func SearchOnModPrevent() SearchOnMod {
+	return func() string {
+		return "prevent"
+	}
+}
+
+// Triggers when the event is outside the element.
+func SearchOnModOutside() SearchOnMod {
+	return func() string {
+		return "outside"
+	}
+}
+
+// Calls 'stopPropagation' on the event listener.
+func SearchOnModStop() SearchOnMod {
+	return func() string {
+		return "stop"
+	}
+}
+
+// Attaches an event listener to an element, executing an expression whenever the
+// event is triggered.
+//
+// See: https://data-star.dev/reference/attributes#data-on
+func (e *SEARCHElement) DATASTAR_ON(key string, expression string, modifiers ...SearchOnMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-on%s", suffix)
+
+	customMods := lo.Map(modifiers, func(m SearchOnMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Attaches an event listener to an element, executing an expression whenever the
+// event is triggered.
+//
+// See: https://data-star.dev/reference/attributes#data-on
+func (e *SEARCHElement) IfDATASTAR_ON(condition bool, key string, expression string, modifiers ...SearchOnMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_ON(key, expression, modifiers...)
+	}
+	return e
+}
+
+// Attaches an event listener to an element, executing an expression whenever the
+// event is triggered.
+//
+// See: https://data-star.dev/reference/attributes#data-on
+// Remove the attribute DATASTAR_ON from the element.
+func (e *SEARCHElement) DATASTAR_ONRemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-on" + suffix)
+
+	return e
+}
+
+type SearchOnIntersectMod customDataKeyModifier
+
+// Only run the expression once
+// Only works with built-in events.
+func SearchOnIntersectModOnce() SearchOnIntersectMod {
+	return func() string {
+		return "once"
+	}
+}
+
+// Trigger when half of the element is visible.
+func SearchOnIntersectModHalf() SearchOnIntersectMod {
+	return func() string {
+		return "half"
+	}
+}
+
+// Trigger when the full element is visible.
+func SearchOnIntersectModFull() SearchOnIntersectMod {
+	return func() string {
+		return "full"
+	}
+}
+
+// Delay the event listener in milliseconds.
+func SearchOnIntersectModDelayMs(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("delay.%dms", d.Milliseconds())
+	}
+}
+
+// Delay the event listener in seconds.
+func SearchOnIntersectModDelaySec(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("delay.%ds", int(d.Seconds()))
+	}
+}
+
+// Debounces the event handler
+func SearchOnIntersectModDebounceMs(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms", d.Milliseconds())
+	}
+}
+
+// Debounce the event listener in milliseconds with leading edge.
+func SearchOnIntersectModDebounceMsLeading(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms.leading", d.Milliseconds())
+	}
+}
+
+// Debounce the event listener in milliseconds without trailing edge.
+func SearchOnIntersectModDebounceMsNoTrailing(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms.notrailing", d.Milliseconds())
+	}
+}
+
+// Debounces the event handler
+func SearchOnIntersectModDebounceSec(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds", int(d.Seconds()))
+	}
+}
+
+// Debounce the event listener in seconds with leading edge.
+func SearchOnIntersectModDebounceSecLeading(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds.leading", int(d.Seconds()))
+	}
+}
+
+// Debounce the event listener in seconds without trailing edge.
+func SearchOnIntersectModDebounceSecNoTrailing(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds.notrailing", int(d.Seconds()))
+	}
+}
+
+// Throttles the event handler
+func SearchOnIntersectModThrottleMs(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms", d.Milliseconds())
+	}
+}
+
+// Throttle the event listener in milliseconds without leading edge.
+func SearchOnIntersectModThrottleMsNoLeading(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms.noleading", d.Milliseconds())
+	}
+}
+
+// Throttle the event listener in milliseconds with trailing edge.
+func SearchOnIntersectModThrottleMsTrailing(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms.trailing", d.Milliseconds())
+	}
+}
+
+// Throttles the event listener in seconds.
+func SearchOnIntersectModThrottleSec(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds", int(d.Seconds()))
+	}
+}
+
+// Throttle the event listener in seconds without leading edge.
+func SearchOnIntersectModThrottleSecNoLeading(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds.noleading", int(d.Seconds()))
+	}
+}
+
+// Throttle the event listener in seconds with trailing edge.
+func SearchOnIntersectModThrottleSecTrailing(
+	d time.Duration,
+) SearchOnIntersectMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds.trailing", int(d.Seconds()))
+	}
+}
+
+// Wraps the expression in 'document.startViewTransition()' when the View
+// Transition API is available.
+func SearchOnIntersectModViewTransition() SearchOnIntersectMod {
+	return func() string {
+		return "viewtransition"
+	}
+}
+
+// Runs an expression when the element intersects with the viewport.
+func (e *SEARCHElement) DATASTAR_ON_INTERSECT(expression string, modifiers ...SearchOnIntersectMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-on-intersect"
+
+	customMods := lo.Map(modifiers, func(m SearchOnIntersectMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Runs an expression when the element intersects with the viewport.
+func (e *SEARCHElement) IfDATASTAR_ON_INTERSECT(condition bool, expression string, modifiers ...SearchOnIntersectMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_ON_INTERSECT(expression, modifiers...)
+	}
+	return e
+}
+
+// Runs an expression when the element intersects with the viewport.
+// Remove the attribute DATASTAR_ON_INTERSECT from the element.
+func (e *SEARCHElement) DATASTAR_ON_INTERSECTRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-on-intersect")
+
+	return e
+}
+
+type SearchOnIntervalMod customDataKeyModifier
+
+// Sets the interval duration in milliseconds.
+func SearchOnIntervalModDurationMs(
+	d time.Duration,
+) SearchOnIntervalMod {
+	return func() string {
+		return fmt.Sprintf("duration.%dms", d.Milliseconds())
+	}
+}
+
+// Sets the interval duration in milliseconds
+// Execute the first interval immediately.
+func SearchOnIntervalModDurationMsLeading(
+	d time.Duration,
+) SearchOnIntervalMod {
+	return func() string {
+		return fmt.Sprintf("duration.%dms.leading", d.Milliseconds())
+	}
+}
+
+// Sets the interval duration in seconds.
+func SearchOnIntervalModDurationSec(
+	d time.Duration,
+) SearchOnIntervalMod {
+	return func() string {
+		return fmt.Sprintf("duration.%ds", int(d.Seconds()))
+	}
+}
+
+// Sets the interval duration in seconds
+// Execute the first interval immediately.
+func SearchOnIntervalModDurationSecLeading(
+	d time.Duration,
+) SearchOnIntervalMod {
+	return func() string {
+		return fmt.Sprintf("duration.%ds.leading", int(d.Seconds()))
+	}
+}
+
+// Wraps the expression in 'document.startViewTransition()' when the View
+// Transition API is available.
+func SearchOnIntervalModViewTransition() SearchOnIntervalMod {
+	return func() string {
+		return "viewtransition"
+	}
+}
+
+// Runs an expression at a regular interval
+// The interval duration defaults to one second and can be modified using the
+// '__duration' modifier.
+//
+// See: https://data-star.dev/reference/attributes#data-on-interval
+func (e *SEARCHElement) DATASTAR_ON_INTERVAL(expression string, modifiers ...SearchOnIntervalMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-on-interval"
+
+	customMods := lo.Map(modifiers, func(m SearchOnIntervalMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Runs an expression at a regular interval
+// The interval duration defaults to one second and can be modified using the
+// '__duration' modifier.
+//
+// See: https://data-star.dev/reference/attributes#data-on-interval
+func (e *SEARCHElement) IfDATASTAR_ON_INTERVAL(condition bool, expression string, modifiers ...SearchOnIntervalMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_ON_INTERVAL(expression, modifiers...)
+	}
+	return e
+}
+
+// Runs an expression at a regular interval
+// The interval duration defaults to one second and can be modified using the
+// '__duration' modifier.
+//
+// See: https://data-star.dev/reference/attributes#data-on-interval
+// Remove the attribute DATASTAR_ON_INTERVAL from the element.
+func (e *SEARCHElement) DATASTAR_ON_INTERVALRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-on-interval")
+
+	return e
+}
+
+type SearchOnSignalPatchMod customDataKeyModifier
+
+// Delay the event listener in milliseconds.
+func SearchOnSignalPatchModDelayMs(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("delay.%dms", d.Milliseconds())
+	}
+}
+
+// Delay the event listener in seconds.
+func SearchOnSignalPatchModDelaySec(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("delay.%ds", int(d.Seconds()))
+	}
+}
+
+// Debounces the event handler
+func SearchOnSignalPatchModDebounceMs(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms", d.Milliseconds())
+	}
+}
+
+// Debounce the event listener in milliseconds with leading edge.
+func SearchOnSignalPatchModDebounceMsLeading(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms.leading", d.Milliseconds())
+	}
+}
+
+// Debounce the event listener in milliseconds without trailing edge.
+func SearchOnSignalPatchModDebounceMsNoTrailing(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%dms.notrailing", d.Milliseconds())
+	}
+}
+
+// Debounces the event handler
+func SearchOnSignalPatchModDebounceSec(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds", int(d.Seconds()))
+	}
+}
+
+// Debounce the event listener in seconds with leading edge.
+func SearchOnSignalPatchModDebounceSecLeading(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds.leading", int(d.Seconds()))
+	}
+}
+
+// Debounce the event listener in seconds without trailing edge.
+func SearchOnSignalPatchModDebounceSecNoTrailing(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("debounce.%ds.notrailing", int(d.Seconds()))
+	}
+}
+
+// Throttles the event handler
+func SearchOnSignalPatchModThrottleMs(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms", d.Milliseconds())
+	}
+}
+
+// Throttle the event listener in milliseconds without leading edge.
+func SearchOnSignalPatchModThrottleMsNoLeading(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms.noleading", d.Milliseconds())
+	}
+}
+
+// Throttle the event listener in milliseconds with trailing edge.
+func SearchOnSignalPatchModThrottleMsTrailing(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%dms.trailing", d.Milliseconds())
+	}
+}
+
+// Throttles the event listener in seconds.
+func SearchOnSignalPatchModThrottleSec(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds", int(d.Seconds()))
+	}
+}
+
+// Throttle the event listener in seconds without leading edge.
+func SearchOnSignalPatchModThrottleSecNoLeading(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds.noleading", int(d.Seconds()))
+	}
+}
+
+// Throttle the event listener in seconds with trailing edge.
+func SearchOnSignalPatchModThrottleSecTrailing(
+	d time.Duration,
+) SearchOnSignalPatchMod {
+	return func() string {
+		return fmt.Sprintf("throttle.%ds.trailing", int(d.Seconds()))
+	}
+}
+
+// Runs an expression whenever any signals are patched
+// This is useful for tracking changes, updating computed values, or triggering
+// side effects when data updates.
+//
+// See: https://data-star.dev/reference/attributes#data-on-signal-patch
+func (e *SEARCHElement) DATASTAR_ON_SIGNAL_PATCH(expression string, modifiers ...SearchOnSignalPatchMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-on-signal-patch"
+
+	customMods := lo.Map(modifiers, func(m SearchOnSignalPatchMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Runs an expression whenever any signals are patched
+// This is useful for tracking changes, updating computed values, or triggering
+// side effects when data updates.
+//
+// See: https://data-star.dev/reference/attributes#data-on-signal-patch
+func (e *SEARCHElement) IfDATASTAR_ON_SIGNAL_PATCH(condition bool, expression string, modifiers ...SearchOnSignalPatchMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_ON_SIGNAL_PATCH(expression, modifiers...)
+	}
+	return e
+}
+
+// Runs an expression whenever any signals are patched
+// This is useful for tracking changes, updating computed values, or triggering
+// side effects when data updates.
+//
+// See: https://data-star.dev/reference/attributes#data-on-signal-patch
+// Remove the attribute DATASTAR_ON_SIGNAL_PATCH from the element.
+func (e *SEARCHElement) DATASTAR_ON_SIGNAL_PATCHRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-on-signal-patch")
+
+	return e
+}
+
+// Filters which signals to watch when using the data-on-signal-patch attribute.
+//
+// The data-on-signal-patch-filter attribute accepts an object with include and/or
+// exclude properties that are regular expressions.
+//
+// See: https://data-star.dev/reference/attributes#data-on-signal-patch-filter
+func (e *SEARCHElement) DATASTAR_ON_SIGNAL_PATCH_FILTER(expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-on-signal-patch-filter"
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Filters which signals to watch when using the data-on-signal-patch attribute.
+//
+// The data-on-signal-patch-filter attribute accepts an object with include and/or
+// exclude properties that are regular expressions.
+//
+// See: https://data-star.dev/reference/attributes#data-on-signal-patch-filter
+func (e *SEARCHElement) IfDATASTAR_ON_SIGNAL_PATCH_FILTER(condition bool, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_ON_SIGNAL_PATCH_FILTER(expression)
+	}
+	return e
+}
+
+// Filters which signals to watch when using the data-on-signal-patch attribute.
+//
+// The data-on-signal-patch-filter attribute accepts an object with include and/or
+// exclude properties that are regular expressions.
+//
+// See: https://data-star.dev/reference/attributes#data-on-signal-patch-filter
+// Remove the attribute DATASTAR_ON_SIGNAL_PATCH_FILTER from the element.
+func (e *SEARCHElement) DATASTAR_ON_SIGNAL_PATCH_FILTERRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-on-signal-patch-filter")
+
+	return e
+}
+
+// Preserves the value of an attribute when morphing DOM elements.
+//
+// See: https://data-star.dev/reference/attributes#data-preserve-attr
+func (e *SEARCHElement) DATASTAR_PRESERVE_ATTR(expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-preserve-attr"
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Preserves the value of an attribute when morphing DOM elements.
+//
+// See: https://data-star.dev/reference/attributes#data-preserve-attr
+func (e *SEARCHElement) IfDATASTAR_PRESERVE_ATTR(condition bool, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_PRESERVE_ATTR(expression)
+	}
+	return e
+}
+
+// Preserves the value of an attribute when morphing DOM elements.
+//
+// See: https://data-star.dev/reference/attributes#data-preserve-attr
+// Remove the attribute DATASTAR_PRESERVE_ATTR from the element.
+func (e *SEARCHElement) DATASTAR_PRESERVE_ATTRRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-preserve-attr")
+
+	return e
+}
+
+type SearchRefMod customDataKeyModifier
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchRefModCase(
+	s string,
+) SearchRefMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Creates a new signal that is a reference to the element on which the data
+// attribute is placed.
+//
+// See: https://data-star.dev/reference/attributes#data-ref
+func (e *SEARCHElement) DATASTAR_REF(expression string, modifiers ...SearchRefMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-ref"
+
+	customMods := lo.Map(modifiers, func(m SearchRefMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Creates a new signal that is a reference to the element on which the data
+// attribute is placed.
+//
+// See: https://data-star.dev/reference/attributes#data-ref
+func (e *SEARCHElement) IfDATASTAR_REF(condition bool, expression string, modifiers ...SearchRefMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_REF(expression, modifiers...)
+	}
+	return e
+}
+
+// Creates a new signal that is a reference to the element on which the data
+// attribute is placed.
+//
+// See: https://data-star.dev/reference/attributes#data-ref
+// Remove the attribute DATASTAR_REF from the element.
+func (e *SEARCHElement) DATASTAR_REFRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-ref")
+
+	return e
+}
+
+// Shows or hides an element based on whether an expression evaluates to 'true' or
+// 'false'
+// For anything with custom requirements, use 'data-class' instead.
+//
+// See: https://data-star.dev/reference/attributes#data-show
+func (e *SEARCHElement) DATASTAR_SHOW(expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-show"
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Shows or hides an element based on whether an expression evaluates to 'true' or
+// 'false'
+// For anything with custom requirements, use 'data-class' instead.
+//
+// See: https://data-star.dev/reference/attributes#data-show
+func (e *SEARCHElement) IfDATASTAR_SHOW(condition bool, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_SHOW(expression)
+	}
+	return e
+}
+
+// Shows or hides an element based on whether an expression evaluates to 'true' or
+// 'false'
+// For anything with custom requirements, use 'data-class' instead.
+//
+// See: https://data-star.dev/reference/attributes#data-show
+// Remove the attribute DATASTAR_SHOW from the element.
+func (e *SEARCHElement) DATASTAR_SHOWRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-show")
+
+	return e
+}
+
+type SearchSignalsMod customDataKeyModifier
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchSignalsModCase(
+	s string,
+) SearchSignalsMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Only patches signals if their keys do not already exist
+// This is useful for setting defaults without overwriting existing values.
+func SearchSignalsModIfMissing() SearchSignalsMod {
+	return func() string {
+		return "ifmissing"
+	}
+}
+
+// Patches (adds, updates or removes) one or more signals into the existing
+// signals
+// Values defined later in the DOM tree override those defined earlier.
+//
+// See: https://data-star.dev/reference/attributes#data-signals
+func (e *SEARCHElement) DATASTAR_SIGNALS(key string, expression string, modifiers ...SearchSignalsMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-signals%s", suffix)
+
+	customMods := lo.Map(modifiers, func(m SearchSignalsMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Patches (adds, updates or removes) one or more signals into the existing
+// signals
+// Values defined later in the DOM tree override those defined earlier.
+//
+// See: https://data-star.dev/reference/attributes#data-signals
+func (e *SEARCHElement) IfDATASTAR_SIGNALS(condition bool, key string, expression string, modifiers ...SearchSignalsMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_SIGNALS(key, expression, modifiers...)
+	}
+	return e
+}
+
+// Patches (adds, updates or removes) one or more signals into the existing
+// signals
+// Values defined later in the DOM tree override those defined earlier.
+//
+// See: https://data-star.dev/reference/attributes#data-signals
+// Remove the attribute DATASTAR_SIGNALS from the element.
+func (e *SEARCHElement) DATASTAR_SIGNALSRemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-signals" + suffix)
+
+	return e
+}
+
+type SearchStyleMod customDataKeyModifier
+
+// Converts the casing of the signal name.
+//   - 'camel' – Camel case: 'mySignal' (default)
+//   - 'kebab' – Kebab case: 'my-signal'
+//   - 'snake' – Snake case: 'my_signal'
+//   - 'pascal' – Pascal case: 'MySignal'
+func SearchStyleModCase(
+	s string,
+) SearchStyleMod {
+	return func() string {
+		return fmt.Sprintf("case.%s", s)
+	}
+}
+
+// Sets the value of inline CSS styles on an element based on an expression, and
+// keeps them in sync.
+//
+// See: https://data-star.dev/reference/attributes#data-style
+func (e *SEARCHElement) DATASTAR_STYLE(key string, expression string, modifiers ...SearchStyleMod) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	key = fmt.Sprintf("data-style%s", suffix)
+
+	customMods := lo.Map(modifiers, func(m SearchStyleMod, i int) customDataKeyModifier {
+		return customDataKeyModifier(m)
+	})
+	key = customDataKey(key, customMods...)
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Sets the value of inline CSS styles on an element based on an expression, and
+// keeps them in sync.
+//
+// See: https://data-star.dev/reference/attributes#data-style
+func (e *SEARCHElement) IfDATASTAR_STYLE(condition bool, key string, expression string, modifiers ...SearchStyleMod) *SEARCHElement {
+	if condition {
+		e.DATASTAR_STYLE(key, expression, modifiers...)
+	}
+	return e
+}
+
+// Sets the value of inline CSS styles on an element based on an expression, and
+// keeps them in sync.
+//
+// See: https://data-star.dev/reference/attributes#data-style
+// Remove the attribute DATASTAR_STYLE from the element.
+func (e *SEARCHElement) DATASTAR_STYLERemove(key string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	suffix := key
+	if suffix != "" {
+		suffix = ":" + suffix
+	}
+	e.StringAttributes.Del("data-style" + suffix)
+
+	return e
+}
+
+// Binds the text content of an element to an expression.
+//
+// See: https://data-star.dev/reference/attributes#data-text
+func (e *SEARCHElement) DATASTAR_TEXT(expression string) *SEARCHElement {
+	if e.StringAttributes == nil {
+		e.StringAttributes = treemap.New[string, string]()
+	}
+
+	key := "data-text"
+
+	e.StringAttributes.Set(key, expression)
+	return e
+}
+
+// Binds the text content of an element to an expression.
+//
+// See: https://data-star.dev/reference/attributes#data-text
+func (e *SEARCHElement) IfDATASTAR_TEXT(condition bool, expression string) *SEARCHElement {
+	if condition {
+		e.DATASTAR_TEXT(expression)
+	}
+	return e
+}
+
+// Binds the text content of an element to an expression.
+//
+// See: https://data-star.dev/reference/attributes#data-text
+// Remove the attribute DATASTAR_TEXT from the element.
+func (e *SEARCHElement) DATASTAR_TEXTRemove() *SEARCHElement {
+	if e.StringAttributes == nil {
+		return e
+	}
+
+	e.StringAttributes.Del("data-text")
+
+	return e
+}
@@ -179,6 +179,16 @@ func (e *SVGCIRCLEElement) IfCX(condition bool, f float64) *SVGCIRCLEElement {
 	return e
 }
 
+// The x-axis coordinate of the center of the circle.
+// Remove the attribute CX from the element.
+func (e *SVGCIRCLEElement) CXRemove(f float64) *SVGCIRCLEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("cx")
+	return e
+}
+
 // The y-axis coordinate of the center of the circle.
 func (e *SVGCIRCLEElement) CY(f float64) *SVGCIRCLEElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *SVGCIRCLEElement) IfCY(condition bool, f float64) *SVGCIRCLEElement {
 	return e
 }
 
+// The y-axis coordinate of the center of the circle.
+// Remove the attribute CY from the element.
+func (e *SVGCIRCLEElement) CYRemove(f float64) *SVGCIRCLEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("cy")
+	return e
+}
+
 // The radius of the circle.
 func (e *SVGCIRCLEElement) R(f float64) *SVGCIRCLEElement {
 	if e.FloatAttributes == nil {
@@ -213,6 +233,16 @@ func (e *SVGCIRCLEElement) IfR(condition bool, f float64) *SVGCIRCLEElement {
 	return e
 }
 
+// The radius of the circle.
+// Remove the attribute R from the element.
+func (e *SVGCIRCLEElement) RRemove(f float64) *SVGCIRCLEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("r")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGCIRCLEElement) ID(s string) *SVGCIRCLEElement {
 	if e.StringAttributes == nil {
@@ -179,6 +179,16 @@ func (e *SVGELLIPSEElement) IfCX(condition bool, f float64) *SVGELLIPSEElement {
 	return e
 }
 
+// The x-axis coordinate of the center of the ellipse.
+// Remove the attribute CX from the element.
+func (e *SVGELLIPSEElement) CXRemove(f float64) *SVGELLIPSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("cx")
+	return e
+}
+
 // The y-axis coordinate of the center of the ellipse.
 func (e *SVGELLIPSEElement) CY(f float64) *SVGELLIPSEElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *SVGELLIPSEElement) IfCY(condition bool, f float64) *SVGELLIPSEElement {
 	return e
 }
 
+// The y-axis coordinate of the center of the ellipse.
+// Remove the attribute CY from the element.
+func (e *SVGELLIPSEElement) CYRemove(f float64) *SVGELLIPSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("cy")
+	return e
+}
+
 // The x-axis radius of the ellipse.
 func (e *SVGELLIPSEElement) RX(f float64) *SVGELLIPSEElement {
 	if e.FloatAttributes == nil {
@@ -213,6 +233,16 @@ func (e *SVGELLIPSEElement) IfRX(condition bool, f float64) *SVGELLIPSEElement {
 	return e
 }
 
+// The x-axis radius of the ellipse.
+// Remove the attribute RX from the element.
+func (e *SVGELLIPSEElement) RXRemove(f float64) *SVGELLIPSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("rx")
+	return e
+}
+
 // The y-axis radius of the ellipse.
 func (e *SVGELLIPSEElement) RY(f float64) *SVGELLIPSEElement {
 	if e.FloatAttributes == nil {
@@ -230,6 +260,16 @@ func (e *SVGELLIPSEElement) IfRY(condition bool, f float64) *SVGELLIPSEElement {
 	return e
 }
 
+// The y-axis radius of the ellipse.
+// Remove the attribute RY from the element.
+func (e *SVGELLIPSEElement) RYRemove(f float64) *SVGELLIPSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("ry")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGELLIPSEElement) ID(s string) *SVGELLIPSEElement {
 	if e.StringAttributes == nil {
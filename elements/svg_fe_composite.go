@@ -310,6 +310,16 @@ func (e *SVGFECOMPOSITEElement) IfK_1(condition bool, f float64) *SVGFECOMPOSITE
 	return e
 }
 
+// First value to use in the arithmetic operation.
+// Remove the attribute K_1 from the element.
+func (e *SVGFECOMPOSITEElement) K_1Remove(f float64) *SVGFECOMPOSITEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("k1")
+	return e
+}
+
 // Second value to use in the arithmetic operation.
 func (e *SVGFECOMPOSITEElement) K_2(f float64) *SVGFECOMPOSITEElement {
 	if e.FloatAttributes == nil {
@@ -327,6 +337,16 @@ func (e *SVGFECOMPOSITEElement) IfK_2(condition bool, f float64) *SVGFECOMPOSITE
 	return e
 }
 
+// Second value to use in the arithmetic operation.
+// Remove the attribute K_2 from the element.
+func (e *SVGFECOMPOSITEElement) K_2Remove(f float64) *SVGFECOMPOSITEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("k2")
+	return e
+}
+
 // Third value to use in the arithmetic operation.
 func (e *SVGFECOMPOSITEElement) K_3(f float64) *SVGFECOMPOSITEElement {
 	if e.FloatAttributes == nil {
@@ -344,6 +364,16 @@ func (e *SVGFECOMPOSITEElement) IfK_3(condition bool, f float64) *SVGFECOMPOSITE
 	return e
 }
 
+// Third value to use in the arithmetic operation.
+// Remove the attribute K_3 from the element.
+func (e *SVGFECOMPOSITEElement) K_3Remove(f float64) *SVGFECOMPOSITEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("k3")
+	return e
+}
+
 // Fourth value to use in the arithmetic operation.
 func (e *SVGFECOMPOSITEElement) K_4(f float64) *SVGFECOMPOSITEElement {
 	if e.FloatAttributes == nil {
@@ -361,6 +391,16 @@ func (e *SVGFECOMPOSITEElement) IfK_4(condition bool, f float64) *SVGFECOMPOSITE
 	return e
 }
 
+// Fourth value to use in the arithmetic operation.
+// Remove the attribute K_4 from the element.
+func (e *SVGFECOMPOSITEElement) K_4Remove(f float64) *SVGFECOMPOSITEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("k4")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFECOMPOSITEElement) ID(s string) *SVGFECOMPOSITEElement {
 	if e.StringAttributes == nil {
@@ -316,6 +316,17 @@ func (e *SVGFECONVOLVEMATRIXElement) IfDIVISOR(condition bool, f float64) *SVGFE
 	return e
 }
 
+// The divisor attribute specifies the value by which to divide the result of
+// applying the convolution operator.
+// Remove the attribute DIVISOR from the element.
+func (e *SVGFECONVOLVEMATRIXElement) DIVISORRemove(f float64) *SVGFECONVOLVEMATRIXElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("divisor")
+	return e
+}
+
 // The bias attribute shifts the range of the filter
 // After applying the matrix operation, this bias value is added to each
 // component.
@@ -337,6 +348,18 @@ func (e *SVGFECONVOLVEMATRIXElement) IfBIAS(condition bool, f float64) *SVGFECON
 	return e
 }
 
+// The bias attribute shifts the range of the filter
+// After applying the matrix operation, this bias value is added to each
+// component.
+// Remove the attribute BIAS from the element.
+func (e *SVGFECONVOLVEMATRIXElement) BIASRemove(f float64) *SVGFECONVOLVEMATRIXElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("bias")
+	return e
+}
+
 // The targetX attribute determines the positioning in X of the convolution matrix
 // relative to a given target pixel in the input image.
 func (e *SVGFECONVOLVEMATRIXElement) TARGET_X(f float64) *SVGFECONVOLVEMATRIXElement {
@@ -356,6 +379,17 @@ func (e *SVGFECONVOLVEMATRIXElement) IfTARGET_X(condition bool, f float64) *SVGF
 	return e
 }
 
+// The targetX attribute determines the positioning in X of the convolution matrix
+// relative to a given target pixel in the input image.
+// Remove the attribute TARGET_X from the element.
+func (e *SVGFECONVOLVEMATRIXElement) TARGET_XRemove(f float64) *SVGFECONVOLVEMATRIXElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("targetX")
+	return e
+}
+
 // The targetY attribute determines the positioning in Y of the convolution matrix
 // relative to a given target pixel in the input image.
 func (e *SVGFECONVOLVEMATRIXElement) TARGET_Y(f float64) *SVGFECONVOLVEMATRIXElement {
@@ -375,6 +409,17 @@ func (e *SVGFECONVOLVEMATRIXElement) IfTARGET_Y(condition bool, f float64) *SVGF
 	return e
 }
 
+// The targetY attribute determines the positioning in Y of the convolution matrix
+// relative to a given target pixel in the input image.
+// Remove the attribute TARGET_Y from the element.
+func (e *SVGFECONVOLVEMATRIXElement) TARGET_YRemove(f float64) *SVGFECONVOLVEMATRIXElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("targetY")
+	return e
+}
+
 // The edgeMode attribute determines how to extend the input image as necessary
 // with color values so that the matrix operations can be applied when the kernel
 // is positioned at or near the edge of the input image.
@@ -228,6 +228,17 @@ func (e *SVGFEDIFFUSELIGHTINGElement) IfSURFACE_SCALE(condition bool, f float64)
 	return e
 }
 
+// The 'surfaceScale' attribute indicates the height of the surface when the alpha
+// channel is 1.0.
+// Remove the attribute SURFACE_SCALE from the element.
+func (e *SVGFEDIFFUSELIGHTINGElement) SURFACE_SCALERemove(f float64) *SVGFEDIFFUSELIGHTINGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("surfaceScale")
+	return e
+}
+
 // The diffuseConstant attribute represents the proportion of the light that is
 // reflected by the surface.
 func (e *SVGFEDIFFUSELIGHTINGElement) DIFFUSE_CONSTANT(f float64) *SVGFEDIFFUSELIGHTINGElement {
@@ -247,6 +258,17 @@ func (e *SVGFEDIFFUSELIGHTINGElement) IfDIFFUSE_CONSTANT(condition bool, f float
 	return e
 }
 
+// The diffuseConstant attribute represents the proportion of the light that is
+// reflected by the surface.
+// Remove the attribute DIFFUSE_CONSTANT from the element.
+func (e *SVGFEDIFFUSELIGHTINGElement) DIFFUSE_CONSTANTRemove(f float64) *SVGFEDIFFUSELIGHTINGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("diffuseConstant")
+	return e
+}
+
 // The kernelUnitLength attribute defines the intended distance in current filter
 // units (i.e., units as determined by the value of attribute 'primitiveUnits')
 // for dx and dy in the surface normal calculation formulas.
@@ -277,6 +277,17 @@ func (e *SVGFEDISPLACEMENTMAPElement) IfSCALE(condition bool, f float64) *SVGFED
 	return e
 }
 
+// The scale attribute defines the maximum value for the in2 displacement
+// A value of 0 disables the effect of the displacement map.
+// Remove the attribute SCALE from the element.
+func (e *SVGFEDISPLACEMENTMAPElement) SCALERemove(f float64) *SVGFEDISPLACEMENTMAPElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("scale")
+	return e
+}
+
 // The xChannelSelector attribute indicates which color channel from in2 to use to
 // displace the pixels in in the horizontal direction.
 func (e *SVGFEDISPLACEMENTMAPElement) X_CHANNEL_SELECTOR(c SVGFeDisplacementMapXChannelSelectorChoice) *SVGFEDISPLACEMENTMAPElement {
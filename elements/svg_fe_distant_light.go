@@ -182,6 +182,17 @@ func (e *SVGFEDISTANTLIGHTElement) IfAZIMUTH(condition bool, f float64) *SVGFEDI
 	return e
 }
 
+// The azimuth attribute represent the direction vector of the light source in the
+// XY plane (clockwise), in degrees from the x axis.
+// Remove the attribute AZIMUTH from the element.
+func (e *SVGFEDISTANTLIGHTElement) AZIMUTHRemove(f float64) *SVGFEDISTANTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("azimuth")
+	return e
+}
+
 // The elevation attribute represent the direction vector of the light source
 // perpendicular to the XY plane, in degrees from the XY plane towards the z axis
 // (clockwise).
@@ -203,6 +214,18 @@ func (e *SVGFEDISTANTLIGHTElement) IfELEVATION(condition bool, f float64) *SVGFE
 	return e
 }
 
+// The elevation attribute represent the direction vector of the light source
+// perpendicular to the XY plane, in degrees from the XY plane towards the z axis
+// (clockwise).
+// Remove the attribute ELEVATION from the element.
+func (e *SVGFEDISTANTLIGHTElement) ELEVATIONRemove(f float64) *SVGFEDISTANTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("elevation")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEDISTANTLIGHTElement) ID(s string) *SVGFEDISTANTLIGHTElement {
 	if e.StringAttributes == nil {
@@ -182,6 +182,17 @@ func (e *SVGFEDROPSHADOWElement) IfDX(condition bool, f float64) *SVGFEDROPSHADO
 	return e
 }
 
+// The amount of offset in the x direction
+// If the <length> is 0, the shadow is placed at the same position as the input.
+// Remove the attribute DX from the element.
+func (e *SVGFEDROPSHADOWElement) DXRemove(f float64) *SVGFEDROPSHADOWElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dx")
+	return e
+}
+
 // The amount of offset in the y direction
 // If the <length> is 0, the shadow is placed at the same position as the input.
 func (e *SVGFEDROPSHADOWElement) DY(f float64) *SVGFEDROPSHADOWElement {
@@ -201,6 +212,17 @@ func (e *SVGFEDROPSHADOWElement) IfDY(condition bool, f float64) *SVGFEDROPSHADO
 	return e
 }
 
+// The amount of offset in the y direction
+// If the <length> is 0, the shadow is placed at the same position as the input.
+// Remove the attribute DY from the element.
+func (e *SVGFEDROPSHADOWElement) DYRemove(f float64) *SVGFEDROPSHADOWElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dy")
+	return e
+}
+
 // The standard deviation for the blur operation
 // If two <numbers> are provided, the first number represents a standard deviation
 // value along the x-axis of the coordinate system established by attribute
@@ -234,6 +256,24 @@ func (e *SVGFEDROPSHADOWElement) IfSTD_DEVIATION(condition bool, f float64) *SVG
 	return e
 }
 
+// The standard deviation for the blur operation
+// If two <numbers> are provided, the first number represents a standard deviation
+// value along the x-axis of the coordinate system established by attribute
+// 'primitiveUnits' on the <filter> element
+// The second value represents a standard deviation in Y
+// If one number is provided, then that value is used for both X and Y
+// Negative values are not allowed
+// A value of zero disables the effect of the given filter primitive (i.e., the
+// result is a transparent black image).
+// Remove the attribute STD_DEVIATION from the element.
+func (e *SVGFEDROPSHADOWElement) STD_DEVIATIONRemove(f float64) *SVGFEDROPSHADOWElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("stdDeviation")
+	return e
+}
+
 // The flood-color attribute indicates what color to use to flood the current
 // filter primitive subregion defined through the <feFlood> element
 // If attribute 'flood-color' is not specified, then the effect is as if a value
@@ -316,6 +356,17 @@ func (e *SVGFEDROPSHADOWElement) IfFLOOD_OPACITY(condition bool, f float64) *SVG
 	return e
 }
 
+// The flood-opacity attribute indicates the opacity value to use across the
+// current filter primitive subregion defined through the <feFlood> element.
+// Remove the attribute FLOOD_OPACITY from the element.
+func (e *SVGFEDROPSHADOWElement) FLOOD_OPACITYRemove(f float64) *SVGFEDROPSHADOWElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("flood-opacity")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEDROPSHADOWElement) ID(s string) *SVGFEDROPSHADOWElement {
 	if e.StringAttributes == nil {
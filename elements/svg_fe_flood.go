@@ -244,6 +244,17 @@ func (e *SVGFEFLOODElement) IfFLOOD_OPACITY(condition bool, f float64) *SVGFEFLO
 	return e
 }
 
+// The flood-opacity attribute indicates the opacity value to use across the
+// current filter primitive subregion defined through the <feFlood> element.
+// Remove the attribute FLOOD_OPACITY from the element.
+func (e *SVGFEFLOODElement) FLOOD_OPACITYRemove(f float64) *SVGFEFLOODElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("flood-opacity")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEFLOODElement) ID(s string) *SVGFEFLOODElement {
 	if e.StringAttributes == nil {
@@ -270,6 +270,16 @@ func (e *SVGFEFUNCAElement) IfSLOPE(condition bool, f float64) *SVGFEFUNCAElemen
 	return e
 }
 
+// The slope attribute indicates the slope of the linear function.
+// Remove the attribute SLOPE from the element.
+func (e *SVGFEFUNCAElement) SLOPERemove(f float64) *SVGFEFUNCAElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("slope")
+	return e
+}
+
 // The intercept attribute indicates the intercept of the linear function.
 func (e *SVGFEFUNCAElement) INTERCEPT(f float64) *SVGFEFUNCAElement {
 	if e.FloatAttributes == nil {
@@ -287,6 +297,16 @@ func (e *SVGFEFUNCAElement) IfINTERCEPT(condition bool, f float64) *SVGFEFUNCAEl
 	return e
 }
 
+// The intercept attribute indicates the intercept of the linear function.
+// Remove the attribute INTERCEPT from the element.
+func (e *SVGFEFUNCAElement) INTERCEPTRemove(f float64) *SVGFEFUNCAElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("intercept")
+	return e
+}
+
 // The amplitude attribute indicates the amplitude of the cubic function.
 func (e *SVGFEFUNCAElement) AMPLITUDE(f float64) *SVGFEFUNCAElement {
 	if e.FloatAttributes == nil {
@@ -304,6 +324,16 @@ func (e *SVGFEFUNCAElement) IfAMPLITUDE(condition bool, f float64) *SVGFEFUNCAEl
 	return e
 }
 
+// The amplitude attribute indicates the amplitude of the cubic function.
+// Remove the attribute AMPLITUDE from the element.
+func (e *SVGFEFUNCAElement) AMPLITUDERemove(f float64) *SVGFEFUNCAElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("amplitude")
+	return e
+}
+
 // The exponent attribute indicates the exponent of the exponential function.
 func (e *SVGFEFUNCAElement) EXPONENT(f float64) *SVGFEFUNCAElement {
 	if e.FloatAttributes == nil {
@@ -321,6 +351,16 @@ func (e *SVGFEFUNCAElement) IfEXPONENT(condition bool, f float64) *SVGFEFUNCAEle
 	return e
 }
 
+// The exponent attribute indicates the exponent of the exponential function.
+// Remove the attribute EXPONENT from the element.
+func (e *SVGFEFUNCAElement) EXPONENTRemove(f float64) *SVGFEFUNCAElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("exponent")
+	return e
+}
+
 // The offset attribute indicates the offset of the function.
 func (e *SVGFEFUNCAElement) OFFSET(f float64) *SVGFEFUNCAElement {
 	if e.FloatAttributes == nil {
@@ -338,6 +378,16 @@ func (e *SVGFEFUNCAElement) IfOFFSET(condition bool, f float64) *SVGFEFUNCAEleme
 	return e
 }
 
+// The offset attribute indicates the offset of the function.
+// Remove the attribute OFFSET from the element.
+func (e *SVGFEFUNCAElement) OFFSETRemove(f float64) *SVGFEFUNCAElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("offset")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEFUNCAElement) ID(s string) *SVGFEFUNCAElement {
 	if e.StringAttributes == nil {
@@ -270,6 +270,16 @@ func (e *SVGFEFUNCBElement) IfSLOPE(condition bool, f float64) *SVGFEFUNCBElemen
 	return e
 }
 
+// The slope attribute indicates the slope of the linear function.
+// Remove the attribute SLOPE from the element.
+func (e *SVGFEFUNCBElement) SLOPERemove(f float64) *SVGFEFUNCBElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("slope")
+	return e
+}
+
 // The intercept attribute indicates the intercept of the linear function.
 func (e *SVGFEFUNCBElement) INTERCEPT(f float64) *SVGFEFUNCBElement {
 	if e.FloatAttributes == nil {
@@ -287,6 +297,16 @@ func (e *SVGFEFUNCBElement) IfINTERCEPT(condition bool, f float64) *SVGFEFUNCBEl
 	return e
 }
 
+// The intercept attribute indicates the intercept of the linear function.
+// Remove the attribute INTERCEPT from the element.
+func (e *SVGFEFUNCBElement) INTERCEPTRemove(f float64) *SVGFEFUNCBElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("intercept")
+	return e
+}
+
 // The amplitude attribute indicates the amplitude of the cubic function.
 func (e *SVGFEFUNCBElement) AMPLITUDE(f float64) *SVGFEFUNCBElement {
 	if e.FloatAttributes == nil {
@@ -304,6 +324,16 @@ func (e *SVGFEFUNCBElement) IfAMPLITUDE(condition bool, f float64) *SVGFEFUNCBEl
 	return e
 }
 
+// The amplitude attribute indicates the amplitude of the cubic function.
+// Remove the attribute AMPLITUDE from the element.
+func (e *SVGFEFUNCBElement) AMPLITUDERemove(f float64) *SVGFEFUNCBElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("amplitude")
+	return e
+}
+
 // The exponent attribute indicates the exponent of the exponential function.
 func (e *SVGFEFUNCBElement) EXPONENT(f float64) *SVGFEFUNCBElement {
 	if e.FloatAttributes == nil {
@@ -321,6 +351,16 @@ func (e *SVGFEFUNCBElement) IfEXPONENT(condition bool, f float64) *SVGFEFUNCBEle
 	return e
 }
 
+// The exponent attribute indicates the exponent of the exponential function.
+// Remove the attribute EXPONENT from the element.
+func (e *SVGFEFUNCBElement) EXPONENTRemove(f float64) *SVGFEFUNCBElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("exponent")
+	return e
+}
+
 // The offset attribute indicates the offset of the function.
 func (e *SVGFEFUNCBElement) OFFSET(f float64) *SVGFEFUNCBElement {
 	if e.FloatAttributes == nil {
@@ -338,6 +378,16 @@ func (e *SVGFEFUNCBElement) IfOFFSET(condition bool, f float64) *SVGFEFUNCBEleme
 	return e
 }
 
+// The offset attribute indicates the offset of the function.
+// Remove the attribute OFFSET from the element.
+func (e *SVGFEFUNCBElement) OFFSETRemove(f float64) *SVGFEFUNCBElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("offset")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEFUNCBElement) ID(s string) *SVGFEFUNCBElement {
 	if e.StringAttributes == nil {
@@ -270,6 +270,16 @@ func (e *SVGFEFUNCGElement) IfSLOPE(condition bool, f float64) *SVGFEFUNCGElemen
 	return e
 }
 
+// The slope attribute indicates the slope of the linear function.
+// Remove the attribute SLOPE from the element.
+func (e *SVGFEFUNCGElement) SLOPERemove(f float64) *SVGFEFUNCGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("slope")
+	return e
+}
+
 // The intercept attribute indicates the intercept of the linear function.
 func (e *SVGFEFUNCGElement) INTERCEPT(f float64) *SVGFEFUNCGElement {
 	if e.FloatAttributes == nil {
@@ -287,6 +297,16 @@ func (e *SVGFEFUNCGElement) IfINTERCEPT(condition bool, f float64) *SVGFEFUNCGEl
 	return e
 }
 
+// The intercept attribute indicates the intercept of the linear function.
+// Remove the attribute INTERCEPT from the element.
+func (e *SVGFEFUNCGElement) INTERCEPTRemove(f float64) *SVGFEFUNCGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("intercept")
+	return e
+}
+
 // The amplitude attribute indicates the amplitude of the cubic function.
 func (e *SVGFEFUNCGElement) AMPLITUDE(f float64) *SVGFEFUNCGElement {
 	if e.FloatAttributes == nil {
@@ -304,6 +324,16 @@ func (e *SVGFEFUNCGElement) IfAMPLITUDE(condition bool, f float64) *SVGFEFUNCGEl
 	return e
 }
 
+// The amplitude attribute indicates the amplitude of the cubic function.
+// Remove the attribute AMPLITUDE from the element.
+func (e *SVGFEFUNCGElement) AMPLITUDERemove(f float64) *SVGFEFUNCGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("amplitude")
+	return e
+}
+
 // The exponent attribute indicates the exponent of the exponential function.
 func (e *SVGFEFUNCGElement) EXPONENT(f float64) *SVGFEFUNCGElement {
 	if e.FloatAttributes == nil {
@@ -321,6 +351,16 @@ func (e *SVGFEFUNCGElement) IfEXPONENT(condition bool, f float64) *SVGFEFUNCGEle
 	return e
 }
 
+// The exponent attribute indicates the exponent of the exponential function.
+// Remove the attribute EXPONENT from the element.
+func (e *SVGFEFUNCGElement) EXPONENTRemove(f float64) *SVGFEFUNCGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("exponent")
+	return e
+}
+
 // The offset attribute indicates the offset of the function.
 func (e *SVGFEFUNCGElement) OFFSET(f float64) *SVGFEFUNCGElement {
 	if e.FloatAttributes == nil {
@@ -338,6 +378,16 @@ func (e *SVGFEFUNCGElement) IfOFFSET(condition bool, f float64) *SVGFEFUNCGEleme
 	return e
 }
 
+// The offset attribute indicates the offset of the function.
+// Remove the attribute OFFSET from the element.
+func (e *SVGFEFUNCGElement) OFFSETRemove(f float64) *SVGFEFUNCGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("offset")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEFUNCGElement) ID(s string) *SVGFEFUNCGElement {
 	if e.StringAttributes == nil {
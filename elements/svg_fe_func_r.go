@@ -270,6 +270,16 @@ func (e *SVGFEFUNCRElement) IfSLOPE(condition bool, f float64) *SVGFEFUNCRElemen
 	return e
 }
 
+// The slope attribute indicates the slope of the linear function.
+// Remove the attribute SLOPE from the element.
+func (e *SVGFEFUNCRElement) SLOPERemove(f float64) *SVGFEFUNCRElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("slope")
+	return e
+}
+
 // The intercept attribute indicates the intercept of the linear function.
 func (e *SVGFEFUNCRElement) INTERCEPT(f float64) *SVGFEFUNCRElement {
 	if e.FloatAttributes == nil {
@@ -287,6 +297,16 @@ func (e *SVGFEFUNCRElement) IfINTERCEPT(condition bool, f float64) *SVGFEFUNCREl
 	return e
 }
 
+// The intercept attribute indicates the intercept of the linear function.
+// Remove the attribute INTERCEPT from the element.
+func (e *SVGFEFUNCRElement) INTERCEPTRemove(f float64) *SVGFEFUNCRElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("intercept")
+	return e
+}
+
 // The amplitude attribute indicates the amplitude of the cubic function.
 func (e *SVGFEFUNCRElement) AMPLITUDE(f float64) *SVGFEFUNCRElement {
 	if e.FloatAttributes == nil {
@@ -304,6 +324,16 @@ func (e *SVGFEFUNCRElement) IfAMPLITUDE(condition bool, f float64) *SVGFEFUNCREl
 	return e
 }
 
+// The amplitude attribute indicates the amplitude of the cubic function.
+// Remove the attribute AMPLITUDE from the element.
+func (e *SVGFEFUNCRElement) AMPLITUDERemove(f float64) *SVGFEFUNCRElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("amplitude")
+	return e
+}
+
 // The exponent attribute indicates the exponent of the exponential function.
 func (e *SVGFEFUNCRElement) EXPONENT(f float64) *SVGFEFUNCRElement {
 	if e.FloatAttributes == nil {
@@ -321,6 +351,16 @@ func (e *SVGFEFUNCRElement) IfEXPONENT(condition bool, f float64) *SVGFEFUNCREle
 	return e
 }
 
+// The exponent attribute indicates the exponent of the exponential function.
+// Remove the attribute EXPONENT from the element.
+func (e *SVGFEFUNCRElement) EXPONENTRemove(f float64) *SVGFEFUNCRElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("exponent")
+	return e
+}
+
 // The offset attribute indicates the offset of the function.
 func (e *SVGFEFUNCRElement) OFFSET(f float64) *SVGFEFUNCRElement {
 	if e.FloatAttributes == nil {
@@ -338,6 +378,16 @@ func (e *SVGFEFUNCRElement) IfOFFSET(condition bool, f float64) *SVGFEFUNCREleme
 	return e
 }
 
+// The offset attribute indicates the offset of the function.
+// Remove the attribute OFFSET from the element.
+func (e *SVGFEFUNCRElement) OFFSETRemove(f float64) *SVGFEFUNCRElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("offset")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEFUNCRElement) ID(s string) *SVGFEFUNCRElement {
 	if e.StringAttributes == nil {
@@ -240,6 +240,24 @@ func (e *SVGFEGAUSSIANBLURElement) IfSTD_DEVIATION(condition bool, f float64) *S
 	return e
 }
 
+// The standard deviation for the blur operation
+// If two <numbers> are provided, the first number represents a standard deviation
+// value along the x-axis of the coordinate system established by attribute
+// 'primitiveUnits' on the <filter> element
+// The second value represents a standard deviation in Y
+// If one number is provided, then that value is used for both X and Y
+// Negative values are not allowed
+// A value of zero disables the effect of the given filter primitive (i.e., the
+// result is a transparent black image).
+// Remove the attribute STD_DEVIATION from the element.
+func (e *SVGFEGAUSSIANBLURElement) STD_DEVIATIONRemove(f float64) *SVGFEGAUSSIANBLURElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("stdDeviation")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEGAUSSIANBLURElement) ID(s string) *SVGFEGAUSSIANBLURElement {
 	if e.StringAttributes == nil {
@@ -253,6 +253,16 @@ func (e *SVGFEMORPHOLOGYElement) IfRADIUS(condition bool, f float64) *SVGFEMORPH
 	return e
 }
 
+// The radius attribute indicates the size of the matrix.
+// Remove the attribute RADIUS from the element.
+func (e *SVGFEMORPHOLOGYElement) RADIUSRemove(f float64) *SVGFEMORPHOLOGYElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("radius")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEMORPHOLOGYElement) ID(s string) *SVGFEMORPHOLOGYElement {
 	if e.StringAttributes == nil {
@@ -179,6 +179,16 @@ func (e *SVGFEOFFSETElement) IfDX(condition bool, f float64) *SVGFEOFFSETElement
 	return e
 }
 
+// The dx attribute indicates a shift along the x-axis on the kernel matrix.
+// Remove the attribute DX from the element.
+func (e *SVGFEOFFSETElement) DXRemove(f float64) *SVGFEOFFSETElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dx")
+	return e
+}
+
 // The dy attribute indicates a shift along the y-axis on the kernel matrix.
 func (e *SVGFEOFFSETElement) DY(f float64) *SVGFEOFFSETElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *SVGFEOFFSETElement) IfDY(condition bool, f float64) *SVGFEOFFSETElement
 	return e
 }
 
+// The dy attribute indicates a shift along the y-axis on the kernel matrix.
+// Remove the attribute DY from the element.
+func (e *SVGFEOFFSETElement) DYRemove(f float64) *SVGFEOFFSETElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dy")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEOFFSETElement) ID(s string) *SVGFEOFFSETElement {
 	if e.StringAttributes == nil {
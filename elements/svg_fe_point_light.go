@@ -181,6 +181,17 @@ func (e *SVGFEPOINTLIGHTElement) IfX(condition bool, f float64) *SVGFEPOINTLIGHT
 	return e
 }
 
+// The x attribute indicates the x location of the light source in the coordinate
+// system established by attribute 'primitiveUnits' on the <filter> element.
+// Remove the attribute X from the element.
+func (e *SVGFEPOINTLIGHTElement) XRemove(f float64) *SVGFEPOINTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y attribute indicates the y location of the light source in the coordinate
 // system established by attribute 'primitiveUnits' on the <filter> element.
 func (e *SVGFEPOINTLIGHTElement) Y(f float64) *SVGFEPOINTLIGHTElement {
@@ -200,6 +211,17 @@ func (e *SVGFEPOINTLIGHTElement) IfY(condition bool, f float64) *SVGFEPOINTLIGHT
 	return e
 }
 
+// The y attribute indicates the y location of the light source in the coordinate
+// system established by attribute 'primitiveUnits' on the <filter> element.
+// Remove the attribute Y from the element.
+func (e *SVGFEPOINTLIGHTElement) YRemove(f float64) *SVGFEPOINTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The z attribute indicates the z location of the light source in the coordinate
 // system established by attribute 'primitiveUnits' on the <filter> element.
 func (e *SVGFEPOINTLIGHTElement) Z(f float64) *SVGFEPOINTLIGHTElement {
@@ -219,6 +241,17 @@ func (e *SVGFEPOINTLIGHTElement) IfZ(condition bool, f float64) *SVGFEPOINTLIGHT
 	return e
 }
 
+// The z attribute indicates the z location of the light source in the coordinate
+// system established by attribute 'primitiveUnits' on the <filter> element.
+// Remove the attribute Z from the element.
+func (e *SVGFEPOINTLIGHTElement) ZRemove(f float64) *SVGFEPOINTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("z")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFEPOINTLIGHTElement) ID(s string) *SVGFEPOINTLIGHTElement {
 	if e.StringAttributes == nil {
@@ -231,6 +231,17 @@ func (e *SVGFESPECULARLIGHTINGElement) IfSURFACE_SCALE(condition bool, f float64
 	return e
 }
 
+// The 'surfaceScale' attribute indicates the height of the surface when the alpha
+// channel is 1.0.
+// Remove the attribute SURFACE_SCALE from the element.
+func (e *SVGFESPECULARLIGHTINGElement) SURFACE_SCALERemove(f float64) *SVGFESPECULARLIGHTINGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("surfaceScale")
+	return e
+}
+
 // The specularConstant attribute represents the diffuse reflection constant.
 func (e *SVGFESPECULARLIGHTINGElement) SPECULAR_CONSTANT(f float64) *SVGFESPECULARLIGHTINGElement {
 	if e.FloatAttributes == nil {
@@ -248,6 +259,16 @@ func (e *SVGFESPECULARLIGHTINGElement) IfSPECULAR_CONSTANT(condition bool, f flo
 	return e
 }
 
+// The specularConstant attribute represents the diffuse reflection constant.
+// Remove the attribute SPECULAR_CONSTANT from the element.
+func (e *SVGFESPECULARLIGHTINGElement) SPECULAR_CONSTANTRemove(f float64) *SVGFESPECULARLIGHTINGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("specularConstant")
+	return e
+}
+
 // The specularExponent attribute represents the specular reflection constant.
 func (e *SVGFESPECULARLIGHTINGElement) SPECULAR_EXPONENT(f float64) *SVGFESPECULARLIGHTINGElement {
 	if e.FloatAttributes == nil {
@@ -265,6 +286,16 @@ func (e *SVGFESPECULARLIGHTINGElement) IfSPECULAR_EXPONENT(condition bool, f flo
 	return e
 }
 
+// The specularExponent attribute represents the specular reflection constant.
+// Remove the attribute SPECULAR_EXPONENT from the element.
+func (e *SVGFESPECULARLIGHTINGElement) SPECULAR_EXPONENTRemove(f float64) *SVGFESPECULARLIGHTINGElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("specularExponent")
+	return e
+}
+
 // The kernelUnitLength attribute defines the intended distance in current filter
 // units (i.e., units as determined by the value of attribute 'primitiveUnits')
 // for dx and dy in the surface normal calculation formulas.
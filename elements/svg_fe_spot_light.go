@@ -181,6 +181,17 @@ func (e *SVGFESPOTLIGHTElement) IfX(condition bool, f float64) *SVGFESPOTLIGHTEl
 	return e
 }
 
+// The x attribute indicates the x location of the light source in the coordinate
+// system established by attribute 'primitiveUnits' on the <filter> element.
+// Remove the attribute X from the element.
+func (e *SVGFESPOTLIGHTElement) XRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y attribute indicates the y location of the light source in the coordinate
 // system established by attribute 'primitiveUnits' on the <filter> element.
 func (e *SVGFESPOTLIGHTElement) Y(f float64) *SVGFESPOTLIGHTElement {
@@ -200,6 +211,17 @@ func (e *SVGFESPOTLIGHTElement) IfY(condition bool, f float64) *SVGFESPOTLIGHTEl
 	return e
 }
 
+// The y attribute indicates the y location of the light source in the coordinate
+// system established by attribute 'primitiveUnits' on the <filter> element.
+// Remove the attribute Y from the element.
+func (e *SVGFESPOTLIGHTElement) YRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The z attribute indicates the z location of the light source in the coordinate
 // system established by attribute 'primitiveUnits' on the <filter> element.
 func (e *SVGFESPOTLIGHTElement) Z(f float64) *SVGFESPOTLIGHTElement {
@@ -219,6 +241,17 @@ func (e *SVGFESPOTLIGHTElement) IfZ(condition bool, f float64) *SVGFESPOTLIGHTEl
 	return e
 }
 
+// The z attribute indicates the z location of the light source in the coordinate
+// system established by attribute 'primitiveUnits' on the <filter> element.
+// Remove the attribute Z from the element.
+func (e *SVGFESPOTLIGHTElement) ZRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("z")
+	return e
+}
+
 // The pointsAtX attribute indicates the x location in the coordinate system
 // established by attribute 'primitiveUnits' on the <filter> element of the point
 // at which the light source is pointing.
@@ -240,6 +273,18 @@ func (e *SVGFESPOTLIGHTElement) IfPOINTS_AT_X(condition bool, f float64) *SVGFES
 	return e
 }
 
+// The pointsAtX attribute indicates the x location in the coordinate system
+// established by attribute 'primitiveUnits' on the <filter> element of the point
+// at which the light source is pointing.
+// Remove the attribute POINTS_AT_X from the element.
+func (e *SVGFESPOTLIGHTElement) POINTS_AT_XRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("pointsAtX")
+	return e
+}
+
 // The pointsAtY attribute indicates the y location in the coordinate system
 // established by attribute 'primitiveUnits' on the <filter> element of the point
 // at which the light source is pointing.
@@ -261,6 +306,18 @@ func (e *SVGFESPOTLIGHTElement) IfPOINTS_AT_Y(condition bool, f float64) *SVGFES
 	return e
 }
 
+// The pointsAtY attribute indicates the y location in the coordinate system
+// established by attribute 'primitiveUnits' on the <filter> element of the point
+// at which the light source is pointing.
+// Remove the attribute POINTS_AT_Y from the element.
+func (e *SVGFESPOTLIGHTElement) POINTS_AT_YRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("pointsAtY")
+	return e
+}
+
 // The pointsAtZ attribute indicates the z location in the coordinate system
 // established by attribute 'primitiveUnits' on the <filter> element of the point
 // at which the light source is pointing.
@@ -282,6 +339,18 @@ func (e *SVGFESPOTLIGHTElement) IfPOINTS_AT_Z(condition bool, f float64) *SVGFES
 	return e
 }
 
+// The pointsAtZ attribute indicates the z location in the coordinate system
+// established by attribute 'primitiveUnits' on the <filter> element of the point
+// at which the light source is pointing.
+// Remove the attribute POINTS_AT_Z from the element.
+func (e *SVGFESPOTLIGHTElement) POINTS_AT_ZRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("pointsAtZ")
+	return e
+}
+
 // The specularExponent attribute represents the specular reflection constant.
 func (e *SVGFESPOTLIGHTElement) SPECULAR_EXPONENT(f float64) *SVGFESPOTLIGHTElement {
 	if e.FloatAttributes == nil {
@@ -299,6 +368,16 @@ func (e *SVGFESPOTLIGHTElement) IfSPECULAR_EXPONENT(condition bool, f float64) *
 	return e
 }
 
+// The specularExponent attribute represents the specular reflection constant.
+// Remove the attribute SPECULAR_EXPONENT from the element.
+func (e *SVGFESPOTLIGHTElement) SPECULAR_EXPONENTRemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("specularExponent")
+	return e
+}
+
 // The limitingConeAngle attribute represents the angle in degrees between the
 // spot light axis and the spot light cone.
 func (e *SVGFESPOTLIGHTElement) LIMITING_CONE_ANGLE(f float64) *SVGFESPOTLIGHTElement {
@@ -318,6 +397,17 @@ func (e *SVGFESPOTLIGHTElement) IfLIMITING_CONE_ANGLE(condition bool, f float64)
 	return e
 }
 
+// The limitingConeAngle attribute represents the angle in degrees between the
+// spot light axis and the spot light cone.
+// Remove the attribute LIMITING_CONE_ANGLE from the element.
+func (e *SVGFESPOTLIGHTElement) LIMITING_CONE_ANGLERemove(f float64) *SVGFESPOTLIGHTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("limitingConeAngle")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGFESPOTLIGHTElement) ID(s string) *SVGFESPOTLIGHTElement {
 	if e.StringAttributes == nil {
@@ -233,6 +233,17 @@ func (e *SVGFETURBULENCEElement) IfNUM_OCTAVES(condition bool, f float64) *SVGFE
 	return e
 }
 
+// The numOctaves attribute indicates the number of octaves to be used by the
+// noise function.
+// Remove the attribute NUM_OCTAVES from the element.
+func (e *SVGFETURBULENCEElement) NUM_OCTAVESRemove(f float64) *SVGFETURBULENCEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("numOctaves")
+	return e
+}
+
 // The seed attribute indicates which number to use to seed the random number
 // generator.
 func (e *SVGFETURBULENCEElement) SEED(f float64) *SVGFETURBULENCEElement {
@@ -252,6 +263,17 @@ func (e *SVGFETURBULENCEElement) IfSEED(condition bool, f float64) *SVGFETURBULE
 	return e
 }
 
+// The seed attribute indicates which number to use to seed the random number
+// generator.
+// Remove the attribute SEED from the element.
+func (e *SVGFETURBULENCEElement) SEEDRemove(f float64) *SVGFETURBULENCEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("seed")
+	return e
+}
+
 // The stitchTiles attribute indicates how the Perlin noise function should be
 // tiled
 // It is ignored if type is not set to 'turbulence'.
@@ -230,6 +230,17 @@ func (e *SVGIMAGEElement) IfX(condition bool, f float64) *SVGIMAGEElement {
 	return e
 }
 
+// The x-axis coordinate of the side of the rectangular region which is closest to
+// the user.
+// Remove the attribute X from the element.
+func (e *SVGIMAGEElement) XRemove(f float64) *SVGIMAGEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y-axis coordinate of the side of the rectangular region which is closest to
 // the user.
 func (e *SVGIMAGEElement) Y(f float64) *SVGIMAGEElement {
@@ -249,6 +260,17 @@ func (e *SVGIMAGEElement) IfY(condition bool, f float64) *SVGIMAGEElement {
 	return e
 }
 
+// The y-axis coordinate of the side of the rectangular region which is closest to
+// the user.
+// Remove the attribute Y from the element.
+func (e *SVGIMAGEElement) YRemove(f float64) *SVGIMAGEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The width of the rectangular region.
 func (e *SVGIMAGEElement) WIDTH(f float64) *SVGIMAGEElement {
 	if e.FloatAttributes == nil {
@@ -266,6 +288,16 @@ func (e *SVGIMAGEElement) IfWIDTH(condition bool, f float64) *SVGIMAGEElement {
 	return e
 }
 
+// The width of the rectangular region.
+// Remove the attribute WIDTH from the element.
+func (e *SVGIMAGEElement) WIDTHRemove(f float64) *SVGIMAGEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("width")
+	return e
+}
+
 // The height of the rectangular region.
 func (e *SVGIMAGEElement) HEIGHT(f float64) *SVGIMAGEElement {
 	if e.FloatAttributes == nil {
@@ -283,6 +315,16 @@ func (e *SVGIMAGEElement) IfHEIGHT(condition bool, f float64) *SVGIMAGEElement {
 	return e
 }
 
+// The height of the rectangular region.
+// Remove the attribute HEIGHT from the element.
+func (e *SVGIMAGEElement) HEIGHTRemove(f float64) *SVGIMAGEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("height")
+	return e
+}
+
 // A URI reference to the image to embed.
 func (e *SVGIMAGEElement) HREF(s string) *SVGIMAGEElement {
 	if e.StringAttributes == nil {
@@ -179,6 +179,16 @@ func (e *SVGLINEElement) IfX_1(condition bool, f float64) *SVGLINEElement {
 	return e
 }
 
+// The x-axis coordinate of the starting point of the line.
+// Remove the attribute X_1 from the element.
+func (e *SVGLINEElement) X_1Remove(f float64) *SVGLINEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x1")
+	return e
+}
+
 // The y-axis coordinate of the starting point of the line.
 func (e *SVGLINEElement) Y_1(f float64) *SVGLINEElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *SVGLINEElement) IfY_1(condition bool, f float64) *SVGLINEElement {
 	return e
 }
 
+// The y-axis coordinate of the starting point of the line.
+// Remove the attribute Y_1 from the element.
+func (e *SVGLINEElement) Y_1Remove(f float64) *SVGLINEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y1")
+	return e
+}
+
 // The x-axis coordinate of the ending point of the line.
 func (e *SVGLINEElement) X_2(f float64) *SVGLINEElement {
 	if e.FloatAttributes == nil {
@@ -213,6 +233,16 @@ func (e *SVGLINEElement) IfX_2(condition bool, f float64) *SVGLINEElement {
 	return e
 }
 
+// The x-axis coordinate of the ending point of the line.
+// Remove the attribute X_2 from the element.
+func (e *SVGLINEElement) X_2Remove(f float64) *SVGLINEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x2")
+	return e
+}
+
 // The y-axis coordinate of the ending point of the line.
 func (e *SVGLINEElement) Y_2(f float64) *SVGLINEElement {
 	if e.FloatAttributes == nil {
@@ -230,6 +260,16 @@ func (e *SVGLINEElement) IfY_2(condition bool, f float64) *SVGLINEElement {
 	return e
 }
 
+// The y-axis coordinate of the ending point of the line.
+// Remove the attribute Y_2 from the element.
+func (e *SVGLINEElement) Y_2Remove(f float64) *SVGLINEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y2")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGLINEElement) ID(s string) *SVGLINEElement {
 	if e.StringAttributes == nil {
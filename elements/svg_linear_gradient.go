@@ -288,6 +288,16 @@ func (e *SVGLINEARGRADIENTElement) IfX_1(condition bool, f float64) *SVGLINEARGR
 	return e
 }
 
+// The x-axis coordinate of the start of the gradient.
+// Remove the attribute X_1 from the element.
+func (e *SVGLINEARGRADIENTElement) X_1Remove(f float64) *SVGLINEARGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x1")
+	return e
+}
+
 // The y-axis coordinate of the start of the gradient.
 func (e *SVGLINEARGRADIENTElement) Y_1(f float64) *SVGLINEARGRADIENTElement {
 	if e.FloatAttributes == nil {
@@ -305,6 +315,16 @@ func (e *SVGLINEARGRADIENTElement) IfY_1(condition bool, f float64) *SVGLINEARGR
 	return e
 }
 
+// The y-axis coordinate of the start of the gradient.
+// Remove the attribute Y_1 from the element.
+func (e *SVGLINEARGRADIENTElement) Y_1Remove(f float64) *SVGLINEARGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y1")
+	return e
+}
+
 // The x-axis coordinate of the end of the gradient.
 func (e *SVGLINEARGRADIENTElement) X_2(f float64) *SVGLINEARGRADIENTElement {
 	if e.FloatAttributes == nil {
@@ -322,6 +342,16 @@ func (e *SVGLINEARGRADIENTElement) IfX_2(condition bool, f float64) *SVGLINEARGR
 	return e
 }
 
+// The x-axis coordinate of the end of the gradient.
+// Remove the attribute X_2 from the element.
+func (e *SVGLINEARGRADIENTElement) X_2Remove(f float64) *SVGLINEARGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x2")
+	return e
+}
+
 // The y-axis coordinate of the end of the gradient.
 func (e *SVGLINEARGRADIENTElement) Y_2(f float64) *SVGLINEARGRADIENTElement {
 	if e.FloatAttributes == nil {
@@ -339,6 +369,16 @@ func (e *SVGLINEARGRADIENTElement) IfY_2(condition bool, f float64) *SVGLINEARGR
 	return e
 }
 
+// The y-axis coordinate of the end of the gradient.
+// Remove the attribute Y_2 from the element.
+func (e *SVGLINEARGRADIENTElement) Y_2Remove(f float64) *SVGLINEARGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y2")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGLINEARGRADIENTElement) ID(s string) *SVGLINEARGRADIENTElement {
 	if e.StringAttributes == nil {
@@ -182,6 +182,17 @@ func (e *SVGMARKERElement) IfREF_X(condition bool, f float64) *SVGMARKERElement
 	return e
 }
 
+// The x-axis coordinate of the reference point which is to be aligned exactly at
+// the marker position.
+// Remove the attribute REF_X from the element.
+func (e *SVGMARKERElement) REF_XRemove(f float64) *SVGMARKERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("refX")
+	return e
+}
+
 // The y-axis coordinate of the reference point which is to be aligned exactly at
 // the marker position.
 func (e *SVGMARKERElement) REF_Y(f float64) *SVGMARKERElement {
@@ -201,6 +212,17 @@ func (e *SVGMARKERElement) IfREF_Y(condition bool, f float64) *SVGMARKERElement
 	return e
 }
 
+// The y-axis coordinate of the reference point which is to be aligned exactly at
+// the marker position.
+// Remove the attribute REF_Y from the element.
+func (e *SVGMARKERElement) REF_YRemove(f float64) *SVGMARKERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("refY")
+	return e
+}
+
 // The width of the marker viewport.
 func (e *SVGMARKERElement) MARKER_WIDTH(f float64) *SVGMARKERElement {
 	if e.FloatAttributes == nil {
@@ -218,6 +240,16 @@ func (e *SVGMARKERElement) IfMARKER_WIDTH(condition bool, f float64) *SVGMARKERE
 	return e
 }
 
+// The width of the marker viewport.
+// Remove the attribute MARKER_WIDTH from the element.
+func (e *SVGMARKERElement) MARKER_WIDTHRemove(f float64) *SVGMARKERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("markerWidth")
+	return e
+}
+
 // The height of the marker viewport.
 func (e *SVGMARKERElement) MARKER_HEIGHT(f float64) *SVGMARKERElement {
 	if e.FloatAttributes == nil {
@@ -235,6 +267,16 @@ func (e *SVGMARKERElement) IfMARKER_HEIGHT(condition bool, f float64) *SVGMARKER
 	return e
 }
 
+// The height of the marker viewport.
+// Remove the attribute MARKER_HEIGHT from the element.
+func (e *SVGMARKERElement) MARKER_HEIGHTRemove(f float64) *SVGMARKERElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("markerHeight")
+	return e
+}
+
 // The orientation of the marker relative to the shape it is attached to.
 func (e *SVGMARKERElement) ORIENT(c SVGMarkerOrientChoice) *SVGMARKERElement {
 	if e.StringAttributes == nil {
@@ -277,6 +277,17 @@ func (e *SVGPATHElement) IfFILL_OPACITY(condition bool, f float64) *SVGPATHEleme
 	return e
 }
 
+// The <path> SVG element is the generic element to define a shape
+// All the basic shapes can be created with a path element.
+// Remove the attribute FILL_OPACITY from the element.
+func (e *SVGPATHElement) FILL_OPACITYRemove(f float64) *SVGPATHElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("fill-opacity")
+	return e
+}
+
 // The total length for the path, in user units.
 func (e *SVGPATHElement) PATH_LENGTH(f float64) *SVGPATHElement {
 	if e.FloatAttributes == nil {
@@ -294,6 +305,16 @@ func (e *SVGPATHElement) IfPATH_LENGTH(condition bool, f float64) *SVGPATHElemen
 	return e
 }
 
+// The total length for the path, in user units.
+// Remove the attribute PATH_LENGTH from the element.
+func (e *SVGPATHElement) PATH_LENGTHRemove(f float64) *SVGPATHElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("pathLength")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGPATHElement) ID(s string) *SVGPATHElement {
 	if e.StringAttributes == nil {
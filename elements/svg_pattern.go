@@ -288,6 +288,17 @@ func (e *SVGPATTERNElement) IfX(condition bool, f float64) *SVGPATTERNElement {
 	return e
 }
 
+// The x-axis coordinate of the side of the rectangular region which is closest to
+// the user.
+// Remove the attribute X from the element.
+func (e *SVGPATTERNElement) XRemove(f float64) *SVGPATTERNElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y-axis coordinate of the side of the rectangular region which is closest to
 // the user.
 func (e *SVGPATTERNElement) Y(f float64) *SVGPATTERNElement {
@@ -307,6 +318,17 @@ func (e *SVGPATTERNElement) IfY(condition bool, f float64) *SVGPATTERNElement {
 	return e
 }
 
+// The y-axis coordinate of the side of the rectangular region which is closest to
+// the user.
+// Remove the attribute Y from the element.
+func (e *SVGPATTERNElement) YRemove(f float64) *SVGPATTERNElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The width of the rectangular region.
 func (e *SVGPATTERNElement) WIDTH(f float64) *SVGPATTERNElement {
 	if e.FloatAttributes == nil {
@@ -324,6 +346,16 @@ func (e *SVGPATTERNElement) IfWIDTH(condition bool, f float64) *SVGPATTERNElemen
 	return e
 }
 
+// The width of the rectangular region.
+// Remove the attribute WIDTH from the element.
+func (e *SVGPATTERNElement) WIDTHRemove(f float64) *SVGPATTERNElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("width")
+	return e
+}
+
 // The height of the rectangular region.
 func (e *SVGPATTERNElement) HEIGHT(f float64) *SVGPATTERNElement {
 	if e.FloatAttributes == nil {
@@ -341,6 +373,16 @@ func (e *SVGPATTERNElement) IfHEIGHT(condition bool, f float64) *SVGPATTERNEleme
 	return e
 }
 
+// The height of the rectangular region.
+// Remove the attribute HEIGHT from the element.
+func (e *SVGPATTERNElement) HEIGHTRemove(f float64) *SVGPATTERNElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("height")
+	return e
+}
+
 // A URI reference to the image to paint.
 func (e *SVGPATTERNElement) HREF(s string) *SVGPATTERNElement {
 	if e.StringAttributes == nil {
@@ -260,6 +260,17 @@ func (e *SVGRADIALGRADIENTElement) IfCX(condition bool, f float64) *SVGRADIALGRA
 	return e
 }
 
+// The x-axis coordinate of the largest (i.e., outermost) circle for the radial
+// gradient.
+// Remove the attribute CX from the element.
+func (e *SVGRADIALGRADIENTElement) CXRemove(f float64) *SVGRADIALGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("cx")
+	return e
+}
+
 // The y-axis coordinate of the largest (i.e., outermost) circle for the radial
 // gradient.
 func (e *SVGRADIALGRADIENTElement) CY(f float64) *SVGRADIALGRADIENTElement {
@@ -279,6 +290,17 @@ func (e *SVGRADIALGRADIENTElement) IfCY(condition bool, f float64) *SVGRADIALGRA
 	return e
 }
 
+// The y-axis coordinate of the largest (i.e., outermost) circle for the radial
+// gradient.
+// Remove the attribute CY from the element.
+func (e *SVGRADIALGRADIENTElement) CYRemove(f float64) *SVGRADIALGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("cy")
+	return e
+}
+
 // The radius of the largest (i.e., outermost) circle for the radial gradient.
 func (e *SVGRADIALGRADIENTElement) R(f float64) *SVGRADIALGRADIENTElement {
 	if e.FloatAttributes == nil {
@@ -296,6 +318,16 @@ func (e *SVGRADIALGRADIENTElement) IfR(condition bool, f float64) *SVGRADIALGRAD
 	return e
 }
 
+// The radius of the largest (i.e., outermost) circle for the radial gradient.
+// Remove the attribute R from the element.
+func (e *SVGRADIALGRADIENTElement) RRemove(f float64) *SVGRADIALGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("r")
+	return e
+}
+
 // The x-axis coordinate of the point at which the focal point of the radial
 // gradient is placed.
 func (e *SVGRADIALGRADIENTElement) FX(f float64) *SVGRADIALGRADIENTElement {
@@ -315,6 +347,17 @@ func (e *SVGRADIALGRADIENTElement) IfFX(condition bool, f float64) *SVGRADIALGRA
 	return e
 }
 
+// The x-axis coordinate of the point at which the focal point of the radial
+// gradient is placed.
+// Remove the attribute FX from the element.
+func (e *SVGRADIALGRADIENTElement) FXRemove(f float64) *SVGRADIALGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("fx")
+	return e
+}
+
 // The y-axis coordinate of the point at which the focal point of the radial
 // gradient is placed.
 func (e *SVGRADIALGRADIENTElement) FY(f float64) *SVGRADIALGRADIENTElement {
@@ -334,6 +377,17 @@ func (e *SVGRADIALGRADIENTElement) IfFY(condition bool, f float64) *SVGRADIALGRA
 	return e
 }
 
+// The y-axis coordinate of the point at which the focal point of the radial
+// gradient is placed.
+// Remove the attribute FY from the element.
+func (e *SVGRADIALGRADIENTElement) FYRemove(f float64) *SVGRADIALGRADIENTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("fy")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGRADIALGRADIENTElement) ID(s string) *SVGRADIALGRADIENTElement {
 	if e.StringAttributes == nil {
@@ -183,6 +183,17 @@ func (e *SVGRECTElement) IfX(condition bool, f float64) *SVGRECTElement {
 	return e
 }
 
+// The x-axis coordinate of the side of the rectangle which has the smaller x-axis
+// value.
+// Remove the attribute X from the element.
+func (e *SVGRECTElement) XRemove(f float64) *SVGRECTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y-axis coordinate of the side of the rectangle which has the smaller y-axis
 // value.
 func (e *SVGRECTElement) Y(f float64) *SVGRECTElement {
@@ -202,6 +213,17 @@ func (e *SVGRECTElement) IfY(condition bool, f float64) *SVGRECTElement {
 	return e
 }
 
+// The y-axis coordinate of the side of the rectangle which has the smaller y-axis
+// value.
+// Remove the attribute Y from the element.
+func (e *SVGRECTElement) YRemove(f float64) *SVGRECTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The width of the rectangle.
 func (e *SVGRECTElement) WIDTH(f float64) *SVGRECTElement {
 	if e.FloatAttributes == nil {
@@ -219,6 +241,16 @@ func (e *SVGRECTElement) IfWIDTH(condition bool, f float64) *SVGRECTElement {
 	return e
 }
 
+// The width of the rectangle.
+// Remove the attribute WIDTH from the element.
+func (e *SVGRECTElement) WIDTHRemove(f float64) *SVGRECTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("width")
+	return e
+}
+
 // The height of the rectangle.
 func (e *SVGRECTElement) HEIGHT(f float64) *SVGRECTElement {
 	if e.FloatAttributes == nil {
@@ -236,6 +268,16 @@ func (e *SVGRECTElement) IfHEIGHT(condition bool, f float64) *SVGRECTElement {
 	return e
 }
 
+// The height of the rectangle.
+// Remove the attribute HEIGHT from the element.
+func (e *SVGRECTElement) HEIGHTRemove(f float64) *SVGRECTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("height")
+	return e
+}
+
 // The x-axis radius of the ellipse used to round off the corners of the
 // rectangle.
 func (e *SVGRECTElement) RX(f float64) *SVGRECTElement {
@@ -255,6 +297,17 @@ func (e *SVGRECTElement) IfRX(condition bool, f float64) *SVGRECTElement {
 	return e
 }
 
+// The x-axis radius of the ellipse used to round off the corners of the
+// rectangle.
+// Remove the attribute RX from the element.
+func (e *SVGRECTElement) RXRemove(f float64) *SVGRECTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("rx")
+	return e
+}
+
 // The y-axis radius of the ellipse used to round off the corners of the
 // rectangle.
 func (e *SVGRECTElement) RY(f float64) *SVGRECTElement {
@@ -274,6 +327,17 @@ func (e *SVGRECTElement) IfRY(condition bool, f float64) *SVGRECTElement {
 	return e
 }
 
+// The y-axis radius of the ellipse used to round off the corners of the
+// rectangle.
+// Remove the attribute RY from the element.
+func (e *SVGRECTElement) RYRemove(f float64) *SVGRECTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("ry")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGRECTElement) ID(s string) *SVGRECTElement {
 	if e.StringAttributes == nil {
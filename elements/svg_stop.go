@@ -180,6 +180,16 @@ func (e *SVGSTOPElement) IfOFFSET(condition bool, f float64) *SVGSTOPElement {
 	return e
 }
 
+// The offset from the start of the gradient where the color first takes effect.
+// Remove the attribute OFFSET from the element.
+func (e *SVGSTOPElement) OFFSETRemove(f float64) *SVGSTOPElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("offset")
+	return e
+}
+
 // The color of the gradient stop.
 func (e *SVGSTOPElement) STOP_COLOR(s string) *SVGSTOPElement {
 	if e.StringAttributes == nil {
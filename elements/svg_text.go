@@ -180,6 +180,16 @@ func (e *SVGTEXTElement) IfX(condition bool, f float64) *SVGTEXTElement {
 	return e
 }
 
+// The x-axis coordinate of the initial current text position.
+// Remove the attribute X from the element.
+func (e *SVGTEXTElement) XRemove(f float64) *SVGTEXTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y-axis coordinate of the initial current text position.
 func (e *SVGTEXTElement) Y(f float64) *SVGTEXTElement {
 	if e.FloatAttributes == nil {
@@ -197,6 +207,16 @@ func (e *SVGTEXTElement) IfY(condition bool, f float64) *SVGTEXTElement {
 	return e
 }
 
+// The y-axis coordinate of the initial current text position.
+// Remove the attribute Y from the element.
+func (e *SVGTEXTElement) YRemove(f float64) *SVGTEXTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The x-axis coordinate of the current text position.
 func (e *SVGTEXTElement) DX(f float64) *SVGTEXTElement {
 	if e.FloatAttributes == nil {
@@ -214,6 +234,16 @@ func (e *SVGTEXTElement) IfDX(condition bool, f float64) *SVGTEXTElement {
 	return e
 }
 
+// The x-axis coordinate of the current text position.
+// Remove the attribute DX from the element.
+func (e *SVGTEXTElement) DXRemove(f float64) *SVGTEXTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dx")
+	return e
+}
+
 // The y-axis coordinate of the current text position.
 func (e *SVGTEXTElement) DY(f float64) *SVGTEXTElement {
 	if e.FloatAttributes == nil {
@@ -231,6 +261,16 @@ func (e *SVGTEXTElement) IfDY(condition bool, f float64) *SVGTEXTElement {
 	return e
 }
 
+// The y-axis coordinate of the current text position.
+// Remove the attribute DY from the element.
+func (e *SVGTEXTElement) DYRemove(f float64) *SVGTEXTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dy")
+	return e
+}
+
 // The rotation angle about the current text position.
 func (e *SVGTEXTElement) ROTATE(f float64) *SVGTEXTElement {
 	if e.FloatAttributes == nil {
@@ -248,6 +288,16 @@ func (e *SVGTEXTElement) IfROTATE(condition bool, f float64) *SVGTEXTElement {
 	return e
 }
 
+// The rotation angle about the current text position.
+// Remove the attribute ROTATE from the element.
+func (e *SVGTEXTElement) ROTATERemove(f float64) *SVGTEXTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("rotate")
+	return e
+}
+
 // The total sum of all of the advance values from rendering all of the characters
 // within this element, including the advance value on the glyph (horizontal or
 // vertical), the effect of properties 'kerning', 'letter-spacing' and
@@ -273,6 +323,20 @@ func (e *SVGTEXTElement) IfTEXT_LENGTH(condition bool, f float64) *SVGTEXTElemen
 	return e
 }
 
+// The total sum of all of the advance values from rendering all of the characters
+// within this element, including the advance value on the glyph (horizontal or
+// vertical), the effect of properties 'kerning', 'letter-spacing' and
+// 'word-spacing' and adjustments due to attributes 'x' and 'y' on the <text>
+// element.
+// Remove the attribute TEXT_LENGTH from the element.
+func (e *SVGTEXTElement) TEXT_LENGTHRemove(f float64) *SVGTEXTElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("textLength")
+	return e
+}
+
 // Indicates how the text is stretched or compressed to fit the textLength.
 func (e *SVGTEXTElement) LENGTH_ADJUST(c SVGTextLengthAdjustChoice) *SVGTEXTElement {
 	if e.StringAttributes == nil {
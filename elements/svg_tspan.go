@@ -179,6 +179,16 @@ func (e *SVGTSPANElement) IfX(condition bool, f float64) *SVGTSPANElement {
 	return e
 }
 
+// The x-axis coordinate of the current text position.
+// Remove the attribute X from the element.
+func (e *SVGTSPANElement) XRemove(f float64) *SVGTSPANElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y-axis coordinate of the current text position.
 func (e *SVGTSPANElement) Y(f float64) *SVGTSPANElement {
 	if e.FloatAttributes == nil {
@@ -196,6 +206,16 @@ func (e *SVGTSPANElement) IfY(condition bool, f float64) *SVGTSPANElement {
 	return e
 }
 
+// The y-axis coordinate of the current text position.
+// Remove the attribute Y from the element.
+func (e *SVGTSPANElement) YRemove(f float64) *SVGTSPANElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The x-axis coordinate of the current text position.
 func (e *SVGTSPANElement) DX(f float64) *SVGTSPANElement {
 	if e.FloatAttributes == nil {
@@ -213,6 +233,16 @@ func (e *SVGTSPANElement) IfDX(condition bool, f float64) *SVGTSPANElement {
 	return e
 }
 
+// The x-axis coordinate of the current text position.
+// Remove the attribute DX from the element.
+func (e *SVGTSPANElement) DXRemove(f float64) *SVGTSPANElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dx")
+	return e
+}
+
 // The y-axis coordinate of the current text position.
 func (e *SVGTSPANElement) DY(f float64) *SVGTSPANElement {
 	if e.FloatAttributes == nil {
@@ -230,6 +260,16 @@ func (e *SVGTSPANElement) IfDY(condition bool, f float64) *SVGTSPANElement {
 	return e
 }
 
+// The y-axis coordinate of the current text position.
+// Remove the attribute DY from the element.
+func (e *SVGTSPANElement) DYRemove(f float64) *SVGTSPANElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("dy")
+	return e
+}
+
 // The rotation angle about the current text position.
 func (e *SVGTSPANElement) ROTATE(f float64) *SVGTSPANElement {
 	if e.FloatAttributes == nil {
@@ -247,6 +287,16 @@ func (e *SVGTSPANElement) IfROTATE(condition bool, f float64) *SVGTSPANElement {
 	return e
 }
 
+// The rotation angle about the current text position.
+// Remove the attribute ROTATE from the element.
+func (e *SVGTSPANElement) ROTATERemove(f float64) *SVGTSPANElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("rotate")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGTSPANElement) ID(s string) *SVGTSPANElement {
 	if e.StringAttributes == nil {
@@ -228,6 +228,17 @@ func (e *SVGUSEElement) IfX(condition bool, f float64) *SVGUSEElement {
 	return e
 }
 
+// The x-axis coordinate of the side of the rectangular region which is closest to
+// the user.
+// Remove the attribute X from the element.
+func (e *SVGUSEElement) XRemove(f float64) *SVGUSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("x")
+	return e
+}
+
 // The y-axis coordinate of the side of the rectangular region which is closest to
 // the user.
 func (e *SVGUSEElement) Y(f float64) *SVGUSEElement {
@@ -247,6 +258,17 @@ func (e *SVGUSEElement) IfY(condition bool, f float64) *SVGUSEElement {
 	return e
 }
 
+// The y-axis coordinate of the side of the rectangular region which is closest to
+// the user.
+// Remove the attribute Y from the element.
+func (e *SVGUSEElement) YRemove(f float64) *SVGUSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("y")
+	return e
+}
+
 // The width of the rectangular region.
 func (e *SVGUSEElement) WIDTH(f float64) *SVGUSEElement {
 	if e.FloatAttributes == nil {
@@ -264,6 +286,16 @@ func (e *SVGUSEElement) IfWIDTH(condition bool, f float64) *SVGUSEElement {
 	return e
 }
 
+// The width of the rectangular region.
+// Remove the attribute WIDTH from the element.
+func (e *SVGUSEElement) WIDTHRemove(f float64) *SVGUSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("width")
+	return e
+}
+
 // The height of the rectangular region.
 func (e *SVGUSEElement) HEIGHT(f float64) *SVGUSEElement {
 	if e.FloatAttributes == nil {
@@ -281,6 +313,16 @@ func (e *SVGUSEElement) IfHEIGHT(condition bool, f float64) *SVGUSEElement {
 	return e
 }
 
+// The height of the rectangular region.
+// Remove the attribute HEIGHT from the element.
+func (e *SVGUSEElement) HEIGHTRemove(f float64) *SVGUSEElement {
+	if e.FloatAttributes == nil {
+		return e
+	}
+	e.FloatAttributes.Del("height")
+	return e
+}
+
 // Specifies a unique id for an element
 func (e *SVGUSEElement) ID(s string) *SVGUSEElement {
 	if e.StringAttributes == nil {
@@ -0,0 +1,73 @@
+// Package email provides MJML-style layout components that compile to the
+// table-based markup email clients (Outlook included) actually render.
+// Compose Section/Columns/Button/Spacer like normal gostar elements; the
+// tables, presentation roles and legacy attributes are handled here.
+package email
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// layoutTable returns a table reset the way email clients need: collapsed
+// spacing, presentation role, full width.
+func layoutTable() *TABLEElement {
+	t := TABLE()
+	t.Attr("role", "presentation")
+	t.Attr("width", "100%")
+	t.Attr("cellpadding", "0")
+	t.Attr("cellspacing", "0")
+	t.Attr("border", "0")
+	return t
+}
+
+// Section wraps children in a full-width table row, the email equivalent of
+// a block-level container.
+func Section(children ...ElementRenderer) ElementRenderer {
+	return layoutTable().CLASS("email-section").Children(TR(TD(children...)))
+}
+
+// Columns lays the given Column cells out side by side in one table row.
+func Columns(cols ...ElementRenderer) ElementRenderer {
+	row := TR()
+	row.Children(cols...)
+	return layoutTable().CLASS("email-columns").Children(row)
+}
+
+// Column is one cell of a Columns row; width is a legacy width value such as
+// "50%" or "300".
+func Column(width string, children ...ElementRenderer) ElementRenderer {
+	td := TD(children...)
+	td.Attr("width", width)
+	td.Attr("valign", "top")
+	return td
+}
+
+// Button renders the bulletproof table-based button: a real link padded by
+// its own cell so Outlook draws the whole shape clickable.
+func Button(href, label string) ElementRenderer {
+	link := A(Escaped(label)).
+		HREF(href).
+		TARGET("_blank").
+		Style("display", "inline-block").
+		Style("text-decoration", "none")
+	td := TD(link).CLASS("email-button")
+	td.Attr("align", "center")
+	t := TABLE(TR(td))
+	t.Attr("role", "presentation")
+	t.Attr("cellpadding", "0")
+	t.Attr("cellspacing", "0")
+	t.Attr("border", "0")
+	return t
+}
+
+// Spacer renders vertical space as a fixed-height cell, since margins are
+// unreliable across email clients.
+func Spacer(height int) ElementRenderer {
+	td := TD(Text("&nbsp;")).
+		Style("font-size", "0").
+		Style("line-height", fmt.Sprintf("%dpx", height))
+	td.Attr("height", fmt.Sprint(height))
+	return layoutTable().CLASS("email-spacer").Children(TR(td))
+}
@@ -0,0 +1,122 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/delaneyj/gostar/elements"
+)
+
+// Plaintext converts an element tree into readable plain text: links become
+// "text (url)", list items become bullets, headings are uppercased and
+// block elements separate paragraphs. Build the text/plain part of a
+// multipart email from the same tree as the HTML part.
+func Plaintext(root elements.ElementRenderer) string {
+	var b strings.Builder
+	writePlain(&b, root, "")
+	out := strings.TrimSpace(b.String())
+	// Collapse the blank-line runs left behind by nested blocks.
+	for strings.Contains(out, "\n\n\n") {
+		out = strings.ReplaceAll(out, "\n\n\n", "\n\n")
+	}
+	return out
+}
+
+var headingTags = map[string]bool{
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+var blockTags = map[string]bool{
+	"p": true, "div": true, "section": true, "article": true,
+	"table": true, "tr": true, "blockquote": true, "ul": true, "ol": true,
+}
+
+func writePlain(b *strings.Builder, r elements.ElementRenderer, bullet string) {
+	switch v := r.(type) {
+	case nil:
+	case *elements.TextContent:
+		b.WriteString(string(*v))
+	case *elements.EscapedContent:
+		b.WriteString(string(*v))
+	case *elements.Grouper:
+		for _, c := range v.Children {
+			writePlain(b, c, bullet)
+		}
+	case elements.BaseElementer:
+		e := v.BaseElement()
+		if e == nil {
+			return
+		}
+		tag := string(e.Tag)
+		switch {
+		case tag == "script" || tag == "style" || tag == "head":
+			return
+		case tag == "br":
+			b.WriteString("\n")
+			return
+		case tag == "img":
+			if e.StringAttributes != nil {
+				if alt, ok := e.StringAttributes.Get("alt"); ok && alt != "" {
+					b.WriteString(alt)
+				}
+			}
+			return
+		case tag == "a":
+			var inner strings.Builder
+			for _, d := range e.Descendants {
+				writePlain(&inner, d, bullet)
+			}
+			b.WriteString(inner.String())
+			if e.StringAttributes != nil {
+				if href, ok := e.StringAttributes.Get("href"); ok && href != "" {
+					fmt.Fprintf(b, " (%s)", href)
+				}
+			}
+			return
+		case headingTags[tag]:
+			var inner strings.Builder
+			for _, d := range e.Descendants {
+				writePlain(&inner, d, bullet)
+			}
+			b.WriteString("\n\n" + strings.ToUpper(strings.TrimSpace(inner.String())) + "\n\n")
+			return
+		case tag == "ul":
+			b.WriteString("\n")
+			for _, d := range e.Descendants {
+				writePlain(b, d, "- ")
+			}
+			b.WriteString("\n")
+			return
+		case tag == "ol":
+			b.WriteString("\n")
+			n := 0
+			for _, d := range e.Descendants {
+				if be, ok := d.(elements.BaseElementer); ok && be.BaseElement() != nil &&
+					string(be.BaseElement().Tag) == "li" {
+					n++
+					writePlain(b, d, fmt.Sprintf("%d. ", n))
+					continue
+				}
+				writePlain(b, d, bullet)
+			}
+			b.WriteString("\n")
+			return
+		case tag == "li":
+			var inner strings.Builder
+			for _, d := range e.Descendants {
+				writePlain(&inner, d, bullet)
+			}
+			b.WriteString(bullet + strings.TrimSpace(inner.String()) + "\n")
+			return
+		}
+		if blockTags[tag] {
+			b.WriteString("\n")
+		}
+		for _, d := range e.Descendants {
+			writePlain(b, d, bullet)
+		}
+		if blockTags[tag] {
+			b.WriteString("\n")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package esi lets a CDN assemble pages from gostar components. A Fragment
+// renders its content inline for ordinary responses, but when the render is
+// wrapped in ForEdge it emits an <esi:include> tag instead, leaving the edge
+// to fetch the fragment from a companion endpoint. Handler serves those
+// endpoints from the same component code, so inline and edge-assembled pages
+// cannot drift apart.
+package esi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// edgeCarrier is implemented by the writer wrapper ForEdge installs.
+type edgeCarrier interface {
+	RenderForEdge() bool
+}
+
+type edgeWriter struct {
+	io.Writer
+}
+
+func (ew *edgeWriter) RenderForEdge() bool {
+	return true
+}
+
+func (ew *edgeWriter) Unwrap() io.Writer {
+	return ew.Writer
+}
+
+type forEdge struct {
+	children []ElementRenderer
+}
+
+func (f *forEdge) Render(w io.Writer) error {
+	return Group(f.children...).Render(&edgeWriter{Writer: w})
+}
+
+// ForEdge renders children with Fragments in its subtree emitting
+// <esi:include> tags instead of content. Wrap the page root with it when the
+// request came through an ESI-capable edge (see EdgeRequested).
+func ForEdge(children ...ElementRenderer) ElementRenderer {
+	return &forEdge{children: children}
+}
+
+// EdgeRequested reports whether the request advertises ESI processing via
+// the Surrogate-Capability header, the signal Varnish and Fastly send.
+func EdgeRequested(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Surrogate-Capability"), "ESI/1.0")
+}
+
+// Control marks the response as containing ESI tags so the edge processes
+// them. Call it before writing a page rendered with ForEdge.
+func Control(w http.ResponseWriter) {
+	w.Header().Set("Surrogate-Control", `content="ESI/1.0"`)
+}
+
+type fragment struct {
+	src     string
+	content ElementRenderer
+}
+
+func (f *fragment) Render(w io.Writer) error {
+	if c, ok := WriterAs[edgeCarrier](w); ok && c.RenderForEdge() {
+		_, err := fmt.Fprintf(w, `<esi:include src="%s"/>`, f.src)
+		return err
+	}
+	if f.content == nil {
+		return nil
+	}
+	return f.content.Render(w)
+}
+
+// Fragment marks content as independently cacheable at src. Inline renders
+// are unchanged; under ForEdge only the include tag is written, so src must
+// be routed to a Handler serving the same content.
+func Fragment(src string, content ElementRenderer) ElementRenderer {
+	return &fragment{src: src, content: content}
+}
+
+// Handler serves fragment endpoints: each src path maps to a function
+// building that fragment's content for the request. Unknown paths 404, so
+// the edge surfaces missing fragments instead of caching empty bodies.
+func Handler(fragments map[string]func(r *http.Request) ElementRenderer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		build, ok := fragments[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := build(r).Render(w); err != nil {
+			http.Error(w, "render failed", http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package expr
+
+import "fmt"
+
+// CopyToClipboard returns an expression copying text to the clipboard via
+// navigator.clipboard, flipping $<signal> to true on success so the page can
+// flash a "copied" hint; reset it with a debounced listener or a timeout in
+// the same expression.
+func CopyToClipboard(text, signal string) string {
+	return fmt.Sprintf(
+		"navigator.clipboard.writeText('%s').then(() => { $%s = true })",
+		JSString(text), signal)
+}
+
+// Share returns an expression invoking the Web Share API with a clipboard
+// fallback where navigator.share is unavailable (desktop browsers mostly):
+// the url is copied instead and $<signal> flips to true.
+func Share(title, url, signal string) string {
+	return fmt.Sprintf(
+		"if (navigator.share) { navigator.share({title: '%s', url: '%s'}) } "+
+			"else { navigator.clipboard.writeText('%s').then(() => { $%s = true }) }",
+		JSString(title), JSString(url), JSString(url), signal)
+}
+
+// JSString makes s safe inside a single-quoted JS string literal. It does
+// not escape for HTML: when the expression lands in an attribute and s
+// carries user data, wrap the result in elements.EscapeF as well so a
+// double quote cannot break out of the attribute.
+func JSString(s string) string {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch r {
+		case '\'', '\\':
+			out = append(out, '\\', r)
+		case '\n':
+			out = append(out, '\\', 'n')
+		default:
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
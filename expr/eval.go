@@ -0,0 +1,395 @@
+package expr
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Eval evaluates a safe subset of datastar expressions against the given
+// signal values: signal references ($name), number/string/boolean/null
+// literals, comparisons, !, && and ||, basic arithmetic and parentheses.
+// Numbers evaluate as float64. Referencing a signal missing from signals is
+// an error, so tests can assert that generated expressions are well formed
+// and only touch declared signals. Anything outside the subset (function
+// calls, assignment, @actions) is rejected.
+func Eval(expression string, signals map[string]any) (any, error) {
+	p, err := newParser(expression)
+	if err != nil {
+		return nil, err
+	}
+	p.signals = signals
+	v, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	return v, nil
+}
+
+// Signals returns the signal names an expression references, in order of
+// first appearance, without evaluating it. It tolerates the full datastar
+// syntax, so it also works on expressions Eval would reject.
+func Signals(expression string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for i := 0; i < len(expression); i++ {
+		if expression[i] != '$' {
+			continue
+		}
+		j := i + 1
+		for j < len(expression) && (isIdentRune(rune(expression[j])) || expression[j] == '.') {
+			j++
+		}
+		if j == i+1 {
+			continue
+		}
+		name := expression[i+1 : j]
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+		i = j - 1
+	}
+	return names
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokString
+	tokIdent  // true, false, null
+	tokSignal // $name
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+type parser struct {
+	tokens  []token
+	pos     int
+	signals map[string]any
+}
+
+func newParser(expression string) (*parser, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &parser{tokens: tokens}, nil
+}
+
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "!", "<", ">", "+", "-", "*", "/", "(", ")"}
+
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		r := rune(s[i])
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			i++
+		case r == '$':
+			j := i + 1
+			for j < len(s) && (isIdentRune(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("bare $ at offset %d", i)
+			}
+			tokens = append(tokens, token{tokSignal, s[i+1 : j]})
+			i = j
+		case r == '\'':
+			j := i + 1
+			var sb strings.Builder
+			for j < len(s) && s[j] != '\'' {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				sb.WriteByte(s[j])
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, token{tokString, sb.String()})
+			i = j + 1
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{tokNumber, s[i:j]})
+			i = j
+		case unicode.IsLetter(r):
+			j := i
+			for j < len(s) && isIdentRune(rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, token{tokIdent, s[i:j]})
+			i = j
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(s[i:], op) {
+					tokens = append(tokens, token{tokOp, op})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unsupported syntax %q at offset %d", s[i], i)
+			}
+		}
+	}
+	return append(tokens, token{tokEOF, ""}), nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) acceptOp(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func truthy(v any) bool {
+	switch x := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return x
+	case float64:
+		return x != 0
+	case string:
+		return x != ""
+	}
+	return true
+}
+
+func (p *parser) parseOr() (any, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.acceptOp("||"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = truthy(left) || truthy(right)
+	}
+}
+
+func (p *parser) parseAnd() (any, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.acceptOp("&&"); !ok {
+			return left, nil
+		}
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = truthy(left) && truthy(right)
+	}
+}
+
+func (p *parser) parseComparison() (any, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.acceptOp("==", "!=", "<=", ">=", "<", ">")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	// reflect.DeepEqual instead of ==: signals can hold uncomparable
+	// values (maps, slices) and == would panic on them.
+	case "==":
+		return reflect.DeepEqual(left, right), nil
+	case "!=":
+		return !reflect.DeepEqual(left, right), nil
+	}
+	lf, lok := left.(float64)
+	rf, rok := right.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("%q needs numbers, got %T and %T", op, left, right)
+	}
+	switch op {
+	case "<":
+		return lf < rf, nil
+	case "<=":
+		return lf <= rf, nil
+	case ">":
+		return lf > rf, nil
+	}
+	return lf >= rf, nil
+}
+
+func (p *parser) parseAdditive() (any, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.acceptOp("+", "-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		if op == "+" {
+			if ls, ok := left.(string); ok {
+				left = ls + fmt.Sprint(right)
+				continue
+			}
+		}
+		lf, lok := left.(float64)
+		rf, rok := right.(float64)
+		if !lok || !rok {
+			return nil, fmt.Errorf("%q needs numbers, got %T and %T", op, left, right)
+		}
+		if op == "+" {
+			left = lf + rf
+		} else {
+			left = lf - rf
+		}
+	}
+}
+
+func (p *parser) parseMultiplicative() (any, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.acceptOp("*", "/")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lf, lok := left.(float64)
+		rf, rok := right.(float64)
+		if !lok || !rok {
+			return nil, fmt.Errorf("%q needs numbers, got %T and %T", op, left, right)
+		}
+		if op == "*" {
+			left = lf * rf
+		} else {
+			left = lf / rf
+		}
+	}
+}
+
+func (p *parser) parseUnary() (any, error) {
+	if _, ok := p.acceptOp("!"); ok {
+		v, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return !truthy(v), nil
+	}
+	if _, ok := p.acceptOp("-"); ok {
+		v, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		f, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("unary - needs a number, got %T", v)
+		}
+		return -f, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (any, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokNumber:
+		p.pos++
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad number %q", t.text)
+		}
+		return f, nil
+	case tokString:
+		p.pos++
+		return t.text, nil
+	case tokIdent:
+		p.pos++
+		switch t.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unsupported identifier %q", t.text)
+	case tokSignal:
+		p.pos++
+		v, ok := p.signals[t.text]
+		if !ok {
+			return nil, fmt.Errorf("undeclared signal $%s", t.text)
+		}
+		switch x := v.(type) {
+		case int:
+			return float64(x), nil
+		}
+		return v, nil
+	case tokOp:
+		if t.text == "(" {
+			p.pos++
+			v, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.acceptOp(")"); !ok {
+				return nil, fmt.Errorf("missing )")
+			}
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
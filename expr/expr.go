@@ -0,0 +1,51 @@
+// Package expr composes datastar expressions for patterns that are easy to
+// get wrong by hand. The builders return plain strings for the DATASTAR_ON
+// setters, so they compose with any element.
+package expr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Optimistic returns an expression that applies update immediately, runs
+// action, and rolls the signal back if the action fails. The previous value
+// is stashed in $<signal>Prev and failures flip $<signal>Error, so the page
+// can surface the rollback.
+//
+//	BUTTON().DATASTAR_ON("click", expr.Optimistic("likes", "$likes++", "@post('/like')"))
+func Optimistic(signal, update, action string) string {
+	return fmt.Sprintf(
+		"$%sPrev = $%s; %s; %s.catch(() => { $%s = $%sPrev; $%sError = true })",
+		signal, signal, update, action, signal, signal, signal)
+}
+
+// Shortcut returns a keydown expression that runs action only when the key
+// combination matches, e.g. "ctrl+k" or "shift+alt+p". The modifier "mod"
+// matches Ctrl or ⌘, for shortcuts that should feel native on every
+// platform. The default is prevented on match so the browser's own binding
+// does not fire.
+func Shortcut(combo, action string) string {
+	parts := strings.Split(strings.ToLower(combo), "+")
+	key := parts[len(parts)-1]
+
+	conditions := []string{}
+	for _, mod := range parts[:len(parts)-1] {
+		switch mod {
+		case "ctrl":
+			conditions = append(conditions, "evt.ctrlKey")
+		case "meta", "cmd":
+			conditions = append(conditions, "evt.metaKey")
+		case "mod":
+			conditions = append(conditions, "(evt.ctrlKey || evt.metaKey)")
+		case "shift":
+			conditions = append(conditions, "evt.shiftKey")
+		case "alt":
+			conditions = append(conditions, "evt.altKey")
+		}
+	}
+	conditions = append(conditions, fmt.Sprintf("evt.key.toLowerCase() === '%s'", key))
+
+	return fmt.Sprintf("if (%s) { evt.preventDefault(); %s }",
+		strings.Join(conditions, " && "), action)
+}
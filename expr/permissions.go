@@ -0,0 +1,37 @@
+package expr
+
+import "fmt"
+
+// Geolocate returns an expression requesting the device position and
+// assigning it into $<signal>Lat/$<signal>Lng; a denial or timeout lands the
+// message in $<signal>Error. Browsers prompt on first use, so wire it to a
+// user gesture such as a button click.
+func Geolocate(signal string) string {
+	return fmt.Sprintf(
+		"navigator.geolocation.getCurrentPosition("+
+			"(pos) => { $%sLat = pos.coords.latitude; $%sLng = pos.coords.longitude }, "+
+			"(err) => { $%sError = err.message })",
+		signal, signal, signal)
+}
+
+// RequestNotifications returns an expression requesting notification
+// permission and storing the outcome ("granted", "denied" or "default") in
+// $<signal>, with failures landing in $<signal>Error.
+func RequestNotifications(signal string) string {
+	return fmt.Sprintf(
+		"Notification.requestPermission()"+
+			".then((p) => { $%s = p })"+
+			".catch((err) => { $%sError = err.message })",
+		signal, signal)
+}
+
+// PermissionGated returns an expression that runs action only when the named
+// permission (a Permissions API name such as "geolocation" or
+// "notifications") is already granted, otherwise records the state in
+// $<signal> so the page can explain what to enable.
+func PermissionGated(permission, signal, action string) string {
+	return fmt.Sprintf(
+		"navigator.permissions.query({name: '%s'}).then((s) => { "+
+			"$%s = s.state; if (s.state === 'granted') { %s } })",
+		JSString(permission), signal, action)
+}
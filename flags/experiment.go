@@ -0,0 +1,108 @@
+package flags
+
+import (
+	"hash/fnv"
+	"io"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Bucketer assigns a render to one of n variants of a named experiment.
+// Assignments must be deterministic per subject so users see a stable
+// variant across pages.
+type Bucketer interface {
+	Bucket(experiment string, n int) int
+}
+
+// HashBucketer buckets by hashing the subject key with the experiment name —
+// stable for a user, independent across experiments.
+type HashBucketer struct {
+	Key string
+}
+
+func (h HashBucketer) Bucket(experiment string, n int) int {
+	if n < 1 {
+		return 0
+	}
+	hash := fnv.New32a()
+	io.WriteString(hash, h.Key)
+	io.WriteString(hash, "\x00")
+	io.WriteString(hash, experiment)
+	return int(hash.Sum32() % uint32(n))
+}
+
+// bucketCarrier is implemented by the writer wrapper WithBucketer installs.
+type bucketCarrier interface {
+	RenderBucketer() (Bucketer, func(experiment string, variant int))
+}
+
+type bucketWriter struct {
+	io.Writer
+	bucketer Bucketer
+	exposure func(experiment string, variant int)
+}
+
+func (bw *bucketWriter) RenderBucketer() (Bucketer, func(string, int)) {
+	return bw.bucketer, bw.exposure
+}
+
+func (bw *bucketWriter) Unwrap() io.Writer {
+	return bw.Writer
+}
+
+type bucketed struct {
+	bucketer Bucketer
+	exposure func(experiment string, variant int)
+	children []ElementRenderer
+}
+
+func (b *bucketed) Render(w io.Writer) error {
+	return Group(b.children...).Render(&bucketWriter{
+		Writer:   w,
+		bucketer: b.bucketer,
+		exposure: b.exposure,
+	})
+}
+
+// WithBucketer renders children with bucketer deciding Experiment variants
+// in its subtree. exposure, if non-nil, is called once per Experiment render
+// with the chosen variant — wire it to the analytics pipeline so only users
+// who actually saw a variant count.
+func WithBucketer(bucketer Bucketer, exposure func(experiment string, variant int), children ...ElementRenderer) ElementRenderer {
+	return &bucketed{bucketer: bucketer, exposure: exposure, children: children}
+}
+
+type experiment struct {
+	name     string
+	variants []ElementRenderer
+}
+
+func (e *experiment) Render(w io.Writer) error {
+	if len(e.variants) == 0 {
+		return nil
+	}
+	idx := 0
+	if c, ok := WriterAs[bucketCarrier](w); ok {
+		bucketer, exposure := c.RenderBucketer()
+		if bucketer != nil {
+			idx = bucketer.Bucket(e.name, len(e.variants))
+			if idx < 0 || idx >= len(e.variants) {
+				idx = 0
+			}
+			if exposure != nil {
+				exposure(e.name, idx)
+			}
+		}
+	}
+	if e.variants[idx] == nil {
+		return nil
+	}
+	return e.variants[idx].Render(w)
+}
+
+// Experiment renders one of variants, chosen by the enclosing WithBucketer;
+// variant 0 is the control and also what renders outside any bucketer, so
+// pages degrade safely.
+func Experiment(name string, variants ...ElementRenderer) ElementRenderer {
+	return &experiment{name: name, variants: variants}
+}
@@ -0,0 +1,104 @@
+// Package flags gates subtrees on feature flags resolved at render time.
+// Install a Provider with WithFlags once per request, then branch with Flag
+// anywhere below — toggling an experiment needs no code churn.
+package flags
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Provider answers whether a named flag is on for this render.
+type Provider interface {
+	Enabled(name string) bool
+}
+
+// Map is the in-memory Provider, handy for tests and per-request overrides.
+type Map map[string]bool
+
+func (m Map) Enabled(name string) bool {
+	return m[name]
+}
+
+// Env resolves flags from environment variables: "new-checkout" with prefix
+// "FLAG_" reads FLAG_NEW_CHECKOUT, on for "1" or "true".
+type Env struct {
+	Prefix string
+}
+
+func (e Env) Enabled(name string) bool {
+	key := e.Prefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+	switch os.Getenv(key) {
+	case "1", "true":
+		return true
+	}
+	return false
+}
+
+// flagCarrier is implemented by the writer wrapper WithFlags installs.
+type flagCarrier interface {
+	RenderFlags() Provider
+}
+
+type flagWriter struct {
+	io.Writer
+	provider Provider
+}
+
+func (fw *flagWriter) RenderFlags() Provider {
+	return fw.provider
+}
+
+func (fw *flagWriter) Unwrap() io.Writer {
+	return fw.Writer
+}
+
+type flagged struct {
+	provider Provider
+	children []ElementRenderer
+}
+
+func (f *flagged) Render(w io.Writer) error {
+	return Group(f.children...).Render(&flagWriter{Writer: w, provider: f.provider})
+}
+
+// WithFlags renders children with provider available to Flag gates in its
+// subtree.
+func WithFlags(provider Provider, children ...ElementRenderer) ElementRenderer {
+	return &flagged{provider: provider, children: children}
+}
+
+// ProviderOf reports the provider installed by the nearest enclosing
+// WithFlags, or nil outside one.
+func ProviderOf(w io.Writer) Provider {
+	if c, ok := WriterAs[flagCarrier](w); ok {
+		return c.RenderFlags()
+	}
+	return nil
+}
+
+type flagGate struct {
+	name    string
+	on, off ElementRenderer
+}
+
+func (g *flagGate) Render(w io.Writer) error {
+	branch := g.off
+	if p := ProviderOf(w); p != nil && p.Enabled(g.name) {
+		branch = g.on
+	}
+	if branch == nil {
+		return nil
+	}
+	return branch.Render(w)
+}
+
+// Flag renders on when name is enabled by the render's flag provider,
+// otherwise off. Either branch may be nil; without a provider the flag is
+// off.
+func Flag(name string, on, off ElementRenderer) ElementRenderer {
+	return &flagGate{name: name, on: on, off: off}
+}
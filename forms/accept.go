@@ -0,0 +1,46 @@
+package forms
+
+import "strings"
+
+// Accept composes the value of a file input's accept attribute from MIME
+// types and extensions, serialized comma-separated per the HTML spec.
+type Accept []string
+
+// AcceptImages accepts any image MIME type.
+func AcceptImages() Accept { return Accept{"image/*"} }
+
+// AcceptAudio accepts any audio MIME type.
+func AcceptAudio() Accept { return Accept{"audio/*"} }
+
+// AcceptVideo accepts any video MIME type.
+func AcceptVideo() Accept { return Accept{"video/*"} }
+
+// AcceptMIME accepts a specific MIME type, e.g. "application/pdf".
+func AcceptMIME(mime string) Accept { return Accept{mime} }
+
+// AcceptExt accepts a file extension; the leading dot is added when missing.
+func AcceptExt(ext string) Accept {
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return Accept{ext}
+}
+
+// And combines accept lists.
+func (a Accept) And(more ...Accept) Accept {
+	out := a
+	for _, m := range more {
+		out = append(out, m...)
+	}
+	return out
+}
+
+// String serializes the list for the accept attribute.
+func (a Accept) String() string {
+	return strings.Join(a, ",")
+}
+
+// WithAccept applies the composed accept list to FileInput.
+func WithAccept(a Accept) UploadOption {
+	return WithUploadAccept(a.String())
+}
@@ -0,0 +1,65 @@
+package forms
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// TimeTrapField is the form field TimeTrap renders and CheckTimeTrap reads.
+const TimeTrapField = "_form_ts"
+
+// Honeypot renders a text input hidden from humans but visible to naive
+// bots; any submission that fills it is spam. Pick a name that looks
+// plausible to a form filler, e.g. "website". Pair with CheckHoneypot.
+func Honeypot(name string) ElementRenderer {
+	wrapper := DIV(
+		INPUT().
+			TYPE(InputType_text).
+			NAME(name).
+			TABINDEX(TabIndexSkip).
+			AUTOCOMPLETE(InputAutocomplete_off),
+	)
+	wrapper.Attr("aria-hidden", "true")
+	wrapper.STYLE("display", "none")
+	return wrapper
+}
+
+// CheckHoneypot returns an error when the honeypot field was filled.
+func CheckHoneypot(form url.Values, name string) error {
+	if form.Get(name) != "" {
+		return fmt.Errorf("honeypot field %q was filled", name)
+	}
+	return nil
+}
+
+// TimeTrap renders a hidden timestamp field recording when the form was
+// served. Pair with CheckTimeTrap: humans take seconds to fill a form, spam
+// scripts submit instantly.
+func TimeTrap(now time.Time) ElementRenderer {
+	return INPUT().
+		TYPE(InputType_hidden).
+		NAME(TimeTrapField).
+		VALUE(strconv.FormatInt(now.Unix(), 10))
+}
+
+// CheckTimeTrap returns an error when the form came back faster than minFill
+// or the timestamp field is missing or mangled. The timestamp is client
+// supplied, so treat this as spam friction rather than proof.
+func CheckTimeTrap(form url.Values, minFill time.Duration, now time.Time) error {
+	raw := form.Get(TimeTrapField)
+	if raw == "" {
+		return fmt.Errorf("time trap field %q missing", TimeTrapField)
+	}
+	served, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return fmt.Errorf("time trap field %q mangled: %w", TimeTrapField, err)
+	}
+	if elapsed := now.Sub(time.Unix(served, 0)); elapsed < minFill {
+		return fmt.Errorf("form submitted after %s, below the %s minimum", elapsed, minFill)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package forms
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// FieldErrors collects validation messages keyed by field name — the
+// contract between a validating handler and the error-rendering components.
+type FieldErrors map[string][]string
+
+// Add appends a message for field.
+func (fe FieldErrors) Add(field, message string) {
+	fe[field] = append(fe[field], message)
+}
+
+// Has reports whether field failed validation.
+func (fe FieldErrors) Has(field string) bool {
+	return len(fe[field]) > 0
+}
+
+// Any reports whether any field failed validation.
+func (fe FieldErrors) Any() bool {
+	for _, msgs := range fe {
+		if len(msgs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorsID is the element id ErrorMessages renders under and aria-describedby
+// points at.
+func ErrorsID(field string) string {
+	return field + "-errors"
+}
+
+// ErrorMessages renders the messages for field adjacent to its input — an
+// alert list the field's aria-describedby references. With no errors it
+// renders nothing, so it can sit unconditionally in the form.
+func ErrorMessages(field string, fe FieldErrors) ElementRenderer {
+	msgs := fe[field]
+	if len(msgs) == 0 {
+		return Group()
+	}
+	list := UL().ID(ErrorsID(field)).CLASS("gostar-field-errors")
+	list.Attr("role", "alert")
+	for _, msg := range msgs {
+		list.Children(LI(Escaped(msg)))
+	}
+	return list
+}
+
+// WireErrors walks a form tree and marks every field present in fe with
+// aria-invalid and aria-describedby pointing at its ErrorMessages block,
+// plus a data-custom-validity expression bound to the field's error signal
+// so datastar keeps native validity in sync. The tree is modified in place
+// and returned for chaining.
+func WireErrors(root ElementRenderer, fe FieldErrors) ElementRenderer {
+	Walk(root, func(e *Element) bool {
+		switch string(e.Tag) {
+		case "input", "textarea", "select":
+		default:
+			return true
+		}
+		name := nameOf(e)
+		if name == "" || !fe.Has(name) {
+			return true
+		}
+		e.Attr("aria-invalid", "true")
+		e.Attr("aria-describedby", ErrorsID(name))
+		e.Attr("data-custom-validity", "$"+SignalName(name)+"Error")
+		return true
+	})
+	return root
+}
@@ -0,0 +1,32 @@
+// Package forms bundles typed helpers for form controls wired to datastar:
+// bound selects, file inputs, accept lists. They compose the generated
+// element builders rather than replacing them, so every helper returns a
+// builder that callers can keep chaining.
+package forms
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SignalName converts a Go-style field name (snake_case, kebab-case or
+// PascalCase) to the camelCase form datastar signals use.
+func SignalName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	})
+	if len(parts) == 0 {
+		return ""
+	}
+	sb := strings.Builder{}
+	for i, p := range parts {
+		runes := []rune(p)
+		if i == 0 {
+			runes[0] = unicode.ToLower(runes[0])
+		} else {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		sb.WriteString(string(runes))
+	}
+	return sb.String()
+}
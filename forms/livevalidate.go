@@ -0,0 +1,43 @@
+package forms
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+)
+
+// LiveValidate wires an input for server-driven progressive validation: each
+// debounced input posts the signals to validateURL, whose handler answers
+// with PushFieldErrors. The error shows through the field's data-custom-validity
+// binding, so no custom JS is involved.
+func LiveValidate(input *INPUTElement, validateURL string) *INPUTElement {
+	name := nameOf(input.BaseElement())
+	input.DATASTAR_ON("input", fmt.Sprintf("@post('%s')", validateURL),
+		InputOnModDebounceMs(300*time.Millisecond))
+	input.Attr("data-custom-validity", "$"+SignalName(name)+"Error")
+	return input
+}
+
+// PushFieldErrors patches the per-field error signals from a validation
+// handler: every field in fe gets its messages joined into $<field>Error,
+// and fields listed in clear get theirs emptied so a fixed field recovers.
+func PushFieldErrors(gen *sse.Generator, fe FieldErrors, clear ...string) error {
+	signals := map[string]string{}
+	for field, msgs := range fe {
+		signals[SignalName(field)+"Error"] = strings.Join(msgs, "; ")
+	}
+	for _, field := range clear {
+		if !fe.Has(field) {
+			signals[SignalName(field)+"Error"] = ""
+		}
+	}
+	payload, err := json.Marshal(signals)
+	if err != nil {
+		return err
+	}
+	return gen.PatchSignals(string(payload))
+}
@@ -0,0 +1,104 @@
+package forms
+
+import (
+	"net/url"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/igrmk/treemap/v2"
+)
+
+// Populate walks a built form tree and fills values, checked and selected
+// states from the submitted url.Values, so re-rendering a form with what the
+// user typed after failed validation is one call. The tree is modified in
+// place and returned for chaining.
+func Populate(root ElementRenderer, values url.Values) ElementRenderer {
+	Walk(root, func(e *Element) bool {
+		name := nameOf(e)
+		if name == "" || !values.Has(name) {
+			return true
+		}
+		submitted := values[name]
+
+		switch string(e.Tag) {
+		case "input":
+			typ, _ := e.StringAttributes.Get("type")
+			switch typ {
+			case "checkbox", "radio":
+				own, _ := e.StringAttributes.Get("value")
+				if own == "" {
+					own = "on"
+				}
+				setBool(e, "checked", contains(submitted, own))
+			case "file":
+				// File inputs cannot be repopulated.
+			default:
+				// Attribute values render verbatim, so escape what the
+				// user typed before it goes back into the value attribute.
+				e.Attr("value", EscapeF("%s", submitted[0]))
+			}
+		case "textarea":
+			e.Descendants = []ElementRenderer{Escaped(submitted[0])}
+		case "select":
+			Walk(e, func(opt *Element) bool {
+				if string(opt.Tag) != "option" {
+					return true
+				}
+				value := ""
+				if opt.StringAttributes != nil {
+					value, _ = opt.StringAttributes.Get("value")
+				}
+				if value == "" {
+					value = textContent(opt)
+				}
+				setBool(opt, "selected", contains(submitted, value))
+				return true
+			})
+			return false
+		}
+		return true
+	})
+	return root
+}
+
+func nameOf(e *Element) string {
+	if e.StringAttributes == nil {
+		return ""
+	}
+	name, _ := e.StringAttributes.Get("name")
+	return name
+}
+
+func setBool(e *Element, key string, on bool) {
+	if on {
+		if e.BoolAttributes == nil {
+			e.BoolAttributes = treemap.New[string, bool]()
+		}
+		e.BoolAttributes.Set(key, true)
+		return
+	}
+	if e.BoolAttributes != nil {
+		e.BoolAttributes.Del(key)
+	}
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func textContent(e *Element) string {
+	out := ""
+	for _, d := range e.Descendants {
+		switch v := d.(type) {
+		case *TextContent:
+			out += string(*v)
+		case *EscapedContent:
+			out += string(*v)
+		}
+	}
+	return out
+}
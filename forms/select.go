@@ -0,0 +1,45 @@
+package forms
+
+import (
+	"slices"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Choice is one entry of a bound select. An empty Label falls back to the
+// value.
+type Choice struct {
+	Value string
+	Label string
+}
+
+// Select renders a single-value select bound to the given signal via
+// data-bind. The name is converted with SignalName, and the choice matching
+// selected (if any) renders with the selected attribute so the initial DOM
+// agrees with the server-side value.
+func Select(name string, choices []Choice, selected ...string) *SELECTElement {
+	el := SELECT().NAME(name).DATASTAR_BIND(SignalName(name), "")
+	return appendChoices(el, choices, selected)
+}
+
+// MultiSelect renders a multiple select bound to an array signal, with every
+// value in selected pre-selected.
+func MultiSelect(name string, choices []Choice, selected ...string) *SELECTElement {
+	el := SELECT().NAME(name).MULTIPLE().DATASTAR_BIND(SignalName(name), "")
+	return appendChoices(el, choices, selected)
+}
+
+func appendChoices(el *SELECTElement, choices []Choice, selected []string) *SELECTElement {
+	for _, c := range choices {
+		label := c.Label
+		if label == "" {
+			label = c.Value
+		}
+		opt := OPTION().VALUE(c.Value).Text(label)
+		if slices.Contains(selected, c.Value) {
+			opt.SELECTED()
+		}
+		el.Children(opt)
+	}
+	return el
+}
@@ -0,0 +1,74 @@
+package forms
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// UploadOption configures FileInput and UploadForm.
+type UploadOption func(*upload)
+
+// WithUploadAccept restricts the file picker to the given MIME types or
+// extensions, rendered as the accept attribute. Compose values with the
+// Accept builder or pass them verbatim.
+func WithUploadAccept(accept string) UploadOption {
+	return func(u *upload) {
+		u.accept = accept
+	}
+}
+
+// WithUploadMultiple allows selecting more than one file.
+func WithUploadMultiple() UploadOption {
+	return func(u *upload) {
+		u.multiple = true
+	}
+}
+
+// WithUploadIndicator sets the signal flipped while the upload is in flight.
+// Defaults to "uploading".
+func WithUploadIndicator(signal string) UploadOption {
+	return func(u *upload) {
+		u.indicator = signal
+	}
+}
+
+type upload struct {
+	accept    string
+	multiple  bool
+	indicator string
+}
+
+// FileInput renders <input type="file"> with the given name, applying accept
+// and multiple options.
+func FileInput(name string, opts ...UploadOption) *INPUTElement {
+	u := &upload{}
+	for _, opt := range opts {
+		opt(u)
+	}
+	el := INPUT().TYPE(InputType_file).NAME(name)
+	if u.accept != "" {
+		el.ACCEPT(u.accept)
+	}
+	if u.multiple {
+		el.MULTIPLE()
+	}
+	return el
+}
+
+// UploadForm wires a multipart form to a datastar @post with
+// contentType 'form', flipping an indicator signal while the request is in
+// flight. It submits via datastar instead of full-page navigation; action is
+// still set so the form degrades without JavaScript.
+func UploadForm(action string, opts ...UploadOption) *FORMElement {
+	u := &upload{indicator: "uploading"}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return FORM().
+		ACTION(action).
+		METHOD(FormMethod_post).
+		ENCTYPE(FormEnctype_multipart_form_data).
+		DATASTAR_INDICATOR(u.indicator).
+		DATASTAR_ON("submit", fmt.Sprintf("@post('%s', {contentType: 'form'})", action))
+}
@@ -0,0 +1,169 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/delaneyj/gostar/cfg/gen/specs/v1"
+	"github.com/goccy/go-json"
+)
+
+// bcdURL is MDN's browser-compat-data, the same dataset MDN pages are built
+// from. It tracks new platform features (e.g. <search>, dialog closedby) well
+// ahead of manual transcription into the committed specs.
+const bcdURL = "https://unpkg.com/@mdn/browser-compat-data/data.json"
+
+// SpecDiff is the result of comparing upstream data against the committed
+// specs: features upstream knows about that the cfg package does not.
+type SpecDiff struct {
+	MissingElements   []string
+	MissingAttributes map[string][]string // element tag -> attribute keys
+}
+
+// Empty reports whether the committed specs fully cover upstream.
+func (d *SpecDiff) Empty() bool {
+	return len(d.MissingElements) == 0 && len(d.MissingAttributes) == 0
+}
+
+func (d *SpecDiff) String() string {
+	if d.Empty() {
+		return "specs are up to date"
+	}
+	sb := &strings.Builder{}
+	if len(d.MissingElements) > 0 {
+		fmt.Fprintf(sb, "missing elements: %s\n", strings.Join(d.MissingElements, ", "))
+	}
+	tags := make([]string, 0, len(d.MissingAttributes))
+	for tag := range d.MissingAttributes {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		fmt.Fprintf(sb, "missing attributes on <%s>: %s\n", tag, strings.Join(d.MissingAttributes[tag], ", "))
+	}
+	return sb.String()
+}
+
+// DiffSpecs fetches MDN browser-compat data (cached under tmpDir so repeated
+// runs don't hammer the CDN) and diffs its HTML and SVG element/attribute
+// inventory against the committed namespaces.
+func DiffSpecs(ctx context.Context, tmpDir string, namespaces *pb.Namespaces) (*SpecDiff, error) {
+	raw, err := fetchBCD(ctx, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var bcd struct {
+		HTML struct {
+			Elements map[string]json.RawMessage `json:"elements"`
+		} `json:"html"`
+		SVG struct {
+			Elements map[string]json.RawMessage `json:"elements"`
+		} `json:"svg"`
+	}
+	if err := json.Unmarshal(raw, &bcd); err != nil {
+		return nil, fmt.Errorf("could not parse browser-compat data: %w", err)
+	}
+
+	diff := &SpecDiff{MissingAttributes: map[string][]string{}}
+	diffNamespace(diff, "html", bcd.HTML.Elements, namespaces)
+	diffNamespace(diff, "svg", bcd.SVG.Elements, namespaces)
+	sort.Strings(diff.MissingElements)
+	return diff, nil
+}
+
+func diffNamespace(diff *SpecDiff, nsName string, upstream map[string]json.RawMessage, namespaces *pb.Namespaces) {
+	var ns *pb.Namespace
+	for _, candidate := range namespaces.Namespaces {
+		if candidate.Name == nsName {
+			ns = candidate
+			break
+		}
+	}
+	if ns == nil {
+		return
+	}
+
+	known := map[string]*pb.Element{}
+	for _, el := range ns.Elements {
+		known[el.Tag] = el
+	}
+	globalAttrs := map[string]bool{}
+	for _, attr := range ns.Attributes {
+		globalAttrs[attr.Key] = true
+	}
+	for _, attr := range namespaces.Attributes {
+		globalAttrs[attr.Key] = true
+	}
+
+	for tag, rawEl := range upstream {
+		el, ok := known[tag]
+		if !ok {
+			diff.MissingElements = append(diff.MissingElements, fmt.Sprintf("%s:%s", nsName, tag))
+			continue
+		}
+
+		// Children of an element entry in BCD are its attributes, plus
+		// bookkeeping keys starting with "__".
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(rawEl, &attrs); err != nil {
+			continue
+		}
+		knownAttrs := map[string]bool{}
+		for _, attr := range el.Attributes {
+			knownAttrs[attr.Key] = true
+		}
+		missing := []string{}
+		for attrKey := range attrs {
+			if strings.HasPrefix(attrKey, "__") {
+				continue
+			}
+			if !knownAttrs[attrKey] && !globalAttrs[attrKey] {
+				missing = append(missing, attrKey)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			diff.MissingAttributes[fmt.Sprintf("%s:%s", nsName, tag)] = missing
+		}
+	}
+}
+
+func fetchBCD(ctx context.Context, tmpDir string) ([]byte, error) {
+	cachePath := filepath.Join(tmpDir, "browser-compat-data.json")
+	if raw, err := os.ReadFile(cachePath); err == nil {
+		return raw, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bcdURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch browser-compat data: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch browser-compat data: status %d", resp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read browser-compat data: %w", err)
+	}
+
+	if err := os.MkdirAll(tmpDir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(cachePath, raw, 0o644); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
@@ -10,6 +10,9 @@ require (
 	github.com/samber/lo v1.38.1
 	github.com/stretchr/testify v1.8.4
 	github.com/valyala/bytebufferpool v1.0.0
+	github.com/yuin/goldmark v1.7.0
+	golang.org/x/net v0.22.0
+	golang.org/x/text v0.14.0
 	google.golang.org/protobuf v1.31.0
 	k8s.io/apimachinery v0.28.4
 )
@@ -34,7 +37,7 @@ require (
 	github.com/go-rod/rod v0.114.5 // indirect
 	github.com/go-task/slim-sprig/v3 v3.0.0
 	github.com/google/uuid v1.4.0 // indirect
-	github.com/iancoleman/strcase v0.3.0 // indirect
+	github.com/iancoleman/strcase v0.3.0
 	github.com/igrmk/treemap/v2 v2.0.1
 	github.com/klauspost/compress v1.17.3 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.6 // indirect
@@ -54,10 +57,10 @@ require (
 	github.com/ysmood/gson v0.7.3 // indirect
 	github.com/ysmood/leakless v0.8.0 // indirect
 	github.com/zeebo/xxh3 v1.0.2 // indirect
-	golang.org/x/crypto v0.15.0 // indirect
+	golang.org/x/crypto v0.21.0 // indirect
 	golang.org/x/exp v0.0.0-20231110203233-9a3e6036ecaa
 	golang.org/x/sync v0.5.0
-	golang.org/x/sys v0.14.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
 	golang.org/x/time v0.4.0 // indirect
 	modernc.org/libc v1.34.4 // indirect
 	modernc.org/mathutil v1.6.0 // indirect
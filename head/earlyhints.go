@@ -0,0 +1,62 @@
+package head
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/delaneyj/gostar/elements"
+)
+
+// LinkHeaders walks root for <link rel="preload|preconnect|modulepreload">
+// elements and returns them formatted as HTTP Link header values, so the
+// hints the head already declares can also travel ahead of the body.
+func LinkHeaders(root elements.ElementRenderer) []string {
+	var headers []string
+	elements.Walk(root, func(e *elements.Element) bool {
+		if string(e.Tag) != "link" || e.StringAttributes == nil {
+			return true
+		}
+		rel, _ := e.StringAttributes.Get("rel")
+		switch rel {
+		case "preload", "preconnect", "modulepreload":
+		default:
+			return true
+		}
+		href, ok := e.StringAttributes.Get("href")
+		if !ok || href == "" {
+			return true
+		}
+		header := fmt.Sprintf("<%s>; rel=%s", href, rel)
+		if as, ok := e.StringAttributes.Get("as"); ok && as != "" {
+			header += "; as=" + as
+		}
+		if cross, ok := e.StringAttributes.Get("crossorigin"); ok {
+			if cross == "" {
+				header += "; crossorigin"
+			} else {
+				header += "; crossorigin=" + cross
+			}
+		}
+		headers = append(headers, header)
+		return true
+	})
+	return headers
+}
+
+// EarlyHints writes a 103 Early Hints interim response carrying root's
+// preload and preconnect links, then leaves w ready for the final response.
+// Call it before any slow work in the handler. The 103 is written
+// unconditionally — there is no reliable way to probe a ResponseWriter for
+// 1xx support. net/http's server treats 1xx codes as interim responses, but
+// a plain recorder or a custom ResponseWriter without that handling records
+// 103 as the final status, so only call it on a real server response.
+func EarlyHints(w http.ResponseWriter, root elements.ElementRenderer) {
+	headers := LinkHeaders(root)
+	if len(headers) == 0 {
+		return
+	}
+	for _, h := range headers {
+		w.Header().Add("Link", h)
+	}
+	w.WriteHeader(http.StatusEarlyHints)
+}
@@ -0,0 +1,48 @@
+package head
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+// FaviconDataURL renders the SVG tree and base64-encodes it into a data: URL
+// suitable for a LINK href, so the icon ships inline with the document.
+func FaviconDataURL(svg ElementRenderer) (string, error) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := svg.Render(buf); err != nil {
+		return "", fmt.Errorf("failed to render favicon svg: %w", err)
+	}
+	return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Favicon renders a rel=icon LINK with the SVG tree inlined as a data: URL.
+func Favicon(svg ElementRenderer) ElementRenderer {
+	href, err := FaviconDataURL(svg)
+	if err != nil {
+		return Error(err)
+	}
+	return LINK().REL("icon").TYPE("image/svg+xml").HREF(href)
+}
+
+// FaviconWithDarkMode renders two rel=icon LINKs with prefers-color-scheme
+// media queries, so the browser picks the variant matching the user's theme.
+func FaviconWithDarkMode(light, dark ElementRenderer) ElementRenderer {
+	lightHref, err := FaviconDataURL(light)
+	if err != nil {
+		return Error(err)
+	}
+	darkHref, err := FaviconDataURL(dark)
+	if err != nil {
+		return Error(err)
+	}
+	return Group(
+		LINK().REL("icon").TYPE("image/svg+xml").HREF(lightHref).
+			MEDIA("(prefers-color-scheme: light)"),
+		LINK().REL("icon").TYPE("image/svg+xml").HREF(darkHref).
+			MEDIA("(prefers-color-scheme: dark)"),
+	)
+}
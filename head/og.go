@@ -0,0 +1,107 @@
+// Package head builds the document-head plumbing that pages share: social
+// card images, favicons, and the meta/link tags that point at them.
+package head
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+// Rasterizer converts a rendered SVG document into PNG bytes. Any SVG
+// renderer (resvg, oksvg, a headless browser) can plug in here; the package
+// stays free of a hard image dependency.
+type Rasterizer interface {
+	Rasterize(svg []byte, width, height int) ([]byte, error)
+}
+
+// RasterizerFunc adapts a function to the Rasterizer interface.
+type RasterizerFunc func(svg []byte, width, height int) ([]byte, error)
+
+func (f RasterizerFunc) Rasterize(svg []byte, width, height int) ([]byte, error) {
+	return f(svg, width, height)
+}
+
+// ogImage is one registered card: the SVG tree that draws it and its pixel
+// size.
+type ogImage struct {
+	build  func() ElementRenderer
+	width  int
+	height int
+}
+
+// OGImages registers Open Graph card renderers per route and serves them as
+// PNG, so social cards live in the same codebase as the pages they preview.
+type OGImages struct {
+	mu         sync.RWMutex
+	rasterizer Rasterizer
+	images     map[string]*ogImage
+}
+
+// NewOGImages returns a registry rasterizing cards with r.
+func NewOGImages(r Rasterizer) *OGImages {
+	return &OGImages{
+		rasterizer: r,
+		images:     map[string]*ogImage{},
+	}
+}
+
+// Register associates the image served at path with the SVG tree build
+// returns. Cards render at the canonical 1200×630 unless overridden with
+// RegisterSized.
+func (o *OGImages) Register(path string, build func() ElementRenderer) {
+	o.RegisterSized(path, 1200, 630, build)
+}
+
+// RegisterSized is Register with an explicit pixel size.
+func (o *OGImages) RegisterSized(path string, width, height int, build func() ElementRenderer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.images[path] = &ogImage{build: build, width: width, height: height}
+}
+
+// Meta returns the og:image meta tags for the card registered at path, for
+// inclusion in the page head. It renders nothing when path is unregistered.
+func (o *OGImages) Meta(path string) ElementRenderer {
+	o.mu.RLock()
+	img, ok := o.images[path]
+	o.mu.RUnlock()
+	if !ok {
+		return Group()
+	}
+	return Group(
+		META().PROPERTY("og:image").CONTENT(path),
+		META().PROPERTY("og:image:width").CONTENT(fmt.Sprint(img.width)),
+		META().PROPERTY("og:image:height").CONTENT(fmt.Sprint(img.height)),
+		META().PROPERTY("og:image:type").CONTENT("image/png"),
+	)
+}
+
+// ServeHTTP renders the card registered at the request path and serves it as
+// PNG. Mount the registry wherever the registered paths resolve.
+func (o *OGImages) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.mu.RLock()
+	img, ok := o.images[r.URL.Path]
+	o.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := img.build().Render(buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	png, err := o.rasterizer.Rasterize(buf.Bytes(), img.width, img.height)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Write(png)
+}
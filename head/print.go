@@ -0,0 +1,42 @@
+package head
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Print / paged-media helpers. Rendered HTML using these feeds cleanly into
+// wkhtmltopdf or WeasyPrint: both honor the @page size emitted by PageSize
+// and the break-* properties behind the utility classes, so
+//
+//	weasyprint page.html page.pdf
+//
+// produces correctly paginated output with no extra flags.
+
+// Utility classes emitted by PrintUtilities.
+const (
+	ClassBreakBefore = "print-break-before"
+	ClassBreakAfter  = "print-break-after"
+	ClassAvoidBreak  = "print-avoid-break"
+)
+
+// PrintStylesheet links a stylesheet applied only when printing.
+func PrintStylesheet(href string) ElementRenderer {
+	return LINK().REL("stylesheet").HREF(href).MEDIA("print")
+}
+
+// PageSize emits the @page rule PDF renderers read for page dimensions and
+// margins, e.g. PageSize("A4", "2cm") or PageSize("letter landscape", "1in").
+func PageSize(size, margin string) ElementRenderer {
+	return STYLE(Text(fmt.Sprintf("@page{size:%s;margin:%s}", size, margin)))
+}
+
+// PrintUtilities emits the page-break utility classes, scoped to print media:
+// ClassBreakBefore starts a new page before the element, ClassBreakAfter
+// after it, and ClassAvoidBreak keeps the element on one page.
+func PrintUtilities() ElementRenderer {
+	return STYLE(Text(fmt.Sprintf(
+		"@media print{.%s{break-before:page}.%s{break-after:page}.%s{break-inside:avoid}}",
+		ClassBreakBefore, ClassBreakAfter, ClassAvoidBreak)))
+}
@@ -0,0 +1,73 @@
+package head
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// Robots is a typed indexing policy, declared once and emitted wherever
+// crawlers look for it: as a robots meta tag in the head and as an
+// X-Robots-Tag response header. The zero value places no restrictions and
+// emits nothing.
+type Robots struct {
+	NoIndex   bool
+	NoFollow  bool
+	NoArchive bool
+	NoSnippet bool
+	// MaxImagePreview bounds image previews in results: "none", "standard"
+	// or "large".
+	MaxImagePreview string
+	// MaxSnippet caps the text snippet length in characters; zero leaves it
+	// to the crawler.
+	MaxSnippet int
+}
+
+func (r Robots) directives() []string {
+	var d []string
+	if r.NoIndex {
+		d = append(d, "noindex")
+	}
+	if r.NoFollow {
+		d = append(d, "nofollow")
+	}
+	if r.NoArchive {
+		d = append(d, "noarchive")
+	}
+	if r.NoSnippet {
+		d = append(d, "nosnippet")
+	}
+	if r.MaxImagePreview != "" {
+		d = append(d, "max-image-preview:"+r.MaxImagePreview)
+	}
+	if r.MaxSnippet > 0 {
+		d = append(d, "max-snippet:"+strconv.Itoa(r.MaxSnippet))
+	}
+	return d
+}
+
+// String renders the policy in the comma-separated form both the meta tag
+// and the header use.
+func (r Robots) String() string {
+	return strings.Join(r.directives(), ", ")
+}
+
+// Meta renders the policy as a robots meta tag for the head, or nothing for
+// the zero policy.
+func (r Robots) Meta() ElementRenderer {
+	content := r.String()
+	if content == "" {
+		return Group()
+	}
+	return META().NAME("robots").CONTENT(content)
+}
+
+// Header sets the policy as an X-Robots-Tag response header, covering
+// non-HTML responses the meta tag cannot reach.
+func (r Robots) Header(w http.ResponseWriter) {
+	if content := r.String(); content != "" {
+		w.Header().Set("X-Robots-Tag", content)
+	}
+}
@@ -0,0 +1,23 @@
+package head
+
+import (
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ColorScheme emits the color-scheme meta declaring which schemes the page
+// supports, e.g. ColorScheme("light", "dark").
+func ColorScheme(schemes ...string) ElementRenderer {
+	return META().NAME("color-scheme").CONTENT(strings.Join(schemes, " "))
+}
+
+// ThemeColor emits theme-color metas with one browser-chrome color per
+// scheme, selected via prefers-color-scheme media queries.
+func ThemeColor(light, dark string) ElementRenderer {
+	lightMeta := META().NAME("theme-color").CONTENT(light)
+	lightMeta.Attr("media", "(prefers-color-scheme: light)")
+	darkMeta := META().NAME("theme-color").CONTENT(dark)
+	darkMeta.Attr("media", "(prefers-color-scheme: dark)")
+	return Group(lightMeta, darkMeta)
+}
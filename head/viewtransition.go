@@ -0,0 +1,11 @@
+package head
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// ViewTransitions opts the document into cross-document view transitions for
+// same-origin navigations.
+func ViewTransitions() ElementRenderer {
+	return META().NAME("view-transition").CONTENT("same-origin")
+}
@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+
+	. "github.com/delaneyj/gostar/elements"
+	"golang.org/x/text/language"
+)
+
+// HreflangAlternates builds the head links telling crawlers about the
+// localized versions of a page: one link rel=alternate hreflang per entry
+// in alternates (locale code → absolute URL) plus an x-default pointing at
+// xDefault. Locale codes are validated as BCP-47 and normalized to their
+// canonical form; links render in sorted order so output is stable.
+func HreflangAlternates(alternates map[string]string, xDefault string) (ElementRenderer, error) {
+	codes := make([]string, 0, len(alternates))
+	for code := range alternates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	links := make([]ElementRenderer, 0, len(codes)+1)
+	for _, code := range codes {
+		tag, err := language.Parse(code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hreflang code %q: %w", code, err)
+		}
+		links = append(links, LINK().
+			REL("alternate").
+			HREFLANG(tag.String()).
+			HREF(alternates[code]))
+	}
+	if xDefault != "" {
+		links = append(links, LINK().
+			REL("alternate").
+			HREFLANG("x-default").
+			HREF(xDefault))
+	}
+	return Group(links...), nil
+}
@@ -0,0 +1,38 @@
+package i18n
+
+import (
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// BdiText renders user-generated text inside <bdi>, so a name or message in
+// the opposite script cannot reorder the punctuation and numbers around it.
+// The text is HTML-escaped.
+func BdiText(s string) ElementRenderer {
+	return BDI().Escaped(s)
+}
+
+// Isolate renders an inline span with dir="auto" and unicode-bidi isolation,
+// for untrusted content that is already an element tree rather than a plain
+// string (use BdiText for strings).
+func Isolate(children ...ElementRenderer) ElementRenderer {
+	return SPAN(children...).
+		DIR(SpanDir_auto).
+		STYLE("unicode-bidi", "isolate")
+}
+
+// AutoDir sets dir="auto" on every element in root that directly contains
+// text, letting the browser pick each block's direction from its first strong
+// character. Apply it as a render option on trees built from untrusted text.
+func AutoDir(root ElementRenderer) ElementRenderer {
+	Walk(root, func(e *Element) bool {
+		for _, d := range e.Descendants {
+			switch d.(type) {
+			case *TextContent, *EscapedContent:
+				e.Attr("dir", "auto")
+				return true
+			}
+		}
+		return true
+	})
+	return root
+}
@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"io"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// TimeStyle selects which parts of a time FormattedTime renders.
+type TimeStyle int
+
+const (
+	TimeStyleDate TimeStyle = iota
+	TimeStyleTime
+	TimeStyleDateTime
+)
+
+// dateLayouts maps base languages to their conventional date layout; locales
+// not listed fall back to ISO 8601, which is unambiguous everywhere.
+var dateLayouts = map[string]string{
+	"en": "Jan 2, 2006",
+	"de": "02.01.2006",
+	"fr": "02/01/2006",
+	"es": "02/01/2006",
+	"it": "02/01/2006",
+	"pt": "02/01/2006",
+	"nl": "02-01-2006",
+	"ja": "2006/01/02",
+	"zh": "2006/01/02",
+}
+
+type formattedTime struct {
+	t     time.Time
+	style TimeStyle
+}
+
+func (f *formattedTime) Render(w io.Writer) error {
+	tag := LocaleOf(w)
+	base, _ := tag.Base()
+	dateLayout, ok := dateLayouts[base.String()]
+	if !ok {
+		dateLayout = "2006-01-02"
+	}
+
+	layout := dateLayout
+	switch f.style {
+	case TimeStyleTime:
+		layout = "15:04"
+	case TimeStyleDateTime:
+		layout = dateLayout + " 15:04"
+	}
+	return Escaped(f.t.Format(layout)).Render(w)
+}
+
+// FormattedTime renders t in the convention of the locale from the enclosing
+// Localize scope, so server-rendered dates match the reader without manual
+// formatting at every call site.
+func FormattedTime(t time.Time, style TimeStyle) ElementRenderer {
+	return &formattedTime{t: t, style: style}
+}
+
+// NumberOption adjusts how FormattedNumber renders its value.
+type NumberOption = number.Option
+
+// WithScale fixes the number of fraction digits.
+func WithScale(digits int) NumberOption {
+	return number.Scale(digits)
+}
+
+type formattedNumber struct {
+	n    float64
+	opts []NumberOption
+}
+
+func (f *formattedNumber) Render(w io.Writer) error {
+	p := message.NewPrinter(LocaleOf(w))
+	return Escaped(p.Sprint(number.Decimal(f.n, f.opts...))).Render(w)
+}
+
+// FormattedNumber renders n with the grouping and decimal separators of the
+// locale from the enclosing Localize scope.
+func FormattedNumber(n float64, opts ...NumberOption) ElementRenderer {
+	return &formattedNumber{n: n, opts: opts}
+}
@@ -0,0 +1,55 @@
+// Package i18n renders locale-aware text nodes. The locale travels with the
+// render, not with the tree: Localize wraps the writer so FormattedTime,
+// FormattedNumber and friends anywhere in its subtree pick it up, and the
+// same tree can serve different locales concurrently.
+package i18n
+
+import (
+	"io"
+
+	. "github.com/delaneyj/gostar/elements"
+	"golang.org/x/text/language"
+)
+
+// localeCarrier is implemented by the writer wrapper Localize installs.
+type localeCarrier interface {
+	Locale() language.Tag
+}
+
+type localeWriter struct {
+	io.Writer
+	tag language.Tag
+}
+
+func (lw *localeWriter) Locale() language.Tag {
+	return lw.tag
+}
+
+func (lw *localeWriter) Unwrap() io.Writer {
+	return lw.Writer
+}
+
+type localized struct {
+	tag      language.Tag
+	children []ElementRenderer
+}
+
+func (l *localized) Render(w io.Writer) error {
+	return Group(l.children...).Render(&localeWriter{Writer: w, tag: l.tag})
+}
+
+// Localize renders children with the given locale available to the
+// locale-aware renderers in its subtree. Wrap the page root once, typically
+// with the tag negotiated from Accept-Language.
+func Localize(tag language.Tag, children ...ElementRenderer) ElementRenderer {
+	return &localized{tag: tag, children: children}
+}
+
+// LocaleOf reports the locale installed by the nearest enclosing Localize,
+// falling back to English.
+func LocaleOf(w io.Writer) language.Tag {
+	if c, ok := WriterAs[localeCarrier](w); ok {
+		return c.Locale()
+	}
+	return language.English
+}
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// safeCite returns cite when it is a relative, http or https URL, and ""
+// otherwise, so attacker-controlled citations cannot smuggle javascript: or
+// data: URLs into the cite attribute.
+func safeCite(cite string) string {
+	u, err := url.Parse(cite)
+	if err != nil {
+		return ""
+	}
+	switch u.Scheme {
+	case "", "http", "https":
+		return u.String()
+	}
+	return ""
+}
+
+// Quote renders an inline <q> citing the given URL, dropping cite values that
+// are not relative, http or https. The browser supplies the quotation marks
+// appropriate for the document language.
+func Quote(cite string, children ...ElementRenderer) ElementRenderer {
+	q := Q(children...)
+	if c := safeCite(cite); c != "" {
+		q.CITE(c)
+	}
+	return q
+}
+
+// Blockquote is Quote for block-level quotations.
+func Blockquote(cite string, children ...ElementRenderer) ElementRenderer {
+	bq := BLOCKQUOTE(children...)
+	if c := safeCite(cite); c != "" {
+		bq.CITE(c)
+	}
+	return bq
+}
+
+// listRules holds the conjunction conventions of a base language.
+type listRules struct {
+	conjunction string
+	oxfordComma bool
+}
+
+var listRulesByLang = map[string]listRules{
+	"en": {"and", true},
+	"de": {"und", false},
+	"fr": {"et", false},
+	"es": {"y", false},
+	"it": {"e", false},
+	"pt": {"e", false},
+	"nl": {"en", false},
+}
+
+type formattedList struct {
+	items []string
+}
+
+func (f *formattedList) Render(w io.Writer) error {
+	base, _ := LocaleOf(w).Base()
+	rules, ok := listRulesByLang[base.String()]
+	if !ok {
+		rules = listRules{conjunction: ",", oxfordComma: false}
+	}
+
+	var text string
+	switch len(f.items) {
+	case 0:
+		return nil
+	case 1:
+		text = f.items[0]
+	case 2:
+		if rules.conjunction == "," {
+			text = f.items[0] + ", " + f.items[1]
+		} else {
+			text = f.items[0] + " " + rules.conjunction + " " + f.items[1]
+		}
+	default:
+		head := strings.Join(f.items[:len(f.items)-1], ", ")
+		last := f.items[len(f.items)-1]
+		switch {
+		case rules.conjunction == ",":
+			text = head + ", " + last
+		case rules.oxfordComma:
+			text = head + ", " + rules.conjunction + " " + last
+		default:
+			text = head + " " + rules.conjunction + " " + last
+		}
+	}
+	return Escaped(text).Render(w)
+}
+
+// FormattedList renders items as running text with the comma and conjunction
+// conventions of the locale from the enclosing Localize scope ("a, b, and c"
+// in English, "a, b und c" in German).
+func FormattedList(items ...string) ElementRenderer {
+	return &formattedList{items: items}
+}
@@ -0,0 +1,93 @@
+// Package icons deduplicates inline SVG icons: register each icon once,
+// render lightweight <use> references at call sites, and emit a single hidden
+// symbol sprite per document.
+package icons
+
+import (
+	"sort"
+	"sync"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// prefix namespaces symbol ids so they cannot collide with page ids.
+const prefix = "gostar-icon-"
+
+type icon struct {
+	viewBox string
+	content []ElementRenderer
+}
+
+// Registry holds registered icons and tracks which ones a page referenced, so
+// the sprite only carries symbols that are actually used.
+type Registry struct {
+	mu    sync.Mutex
+	icons map[string]icon
+	used  map[string]struct{}
+}
+
+// NewRegistry returns an empty icon registry. Use one per rendered document;
+// sharing across documents would leak usage between pages.
+func NewRegistry() *Registry {
+	return &Registry{
+		icons: map[string]icon{},
+		used:  map[string]struct{}{},
+	}
+}
+
+// Register adds an icon under name with its viewBox and inner SVG content.
+// Registering the same name again replaces the previous definition.
+func (r *Registry) Register(name, viewBox string, content ...ElementRenderer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.icons[name] = icon{viewBox: viewBox, content: content}
+}
+
+// Icon renders a reference to the named icon and marks it used, so Sprite
+// includes its symbol. Unregistered names render nothing.
+func (r *Registry) Icon(name string, classes ...string) ElementRenderer {
+	r.mu.Lock()
+	ic, ok := r.icons[name]
+	if ok {
+		r.used[name] = struct{}{}
+	}
+	r.mu.Unlock()
+	if !ok {
+		return Group()
+	}
+
+	svg := SVG_SVG(SVG_USE().HREF("#" + prefix + name)).VIEW_BOX(ic.viewBox)
+	if len(classes) > 0 {
+		svg.CLASS(classes...)
+	}
+	svg.Attr("aria-hidden", "true")
+	return svg
+}
+
+// Sprite emits the hidden symbol sheet for every icon referenced so far, in
+// name order for deterministic output. Render it once per document, typically
+// at the top of body.
+func (r *Registry) Sprite() ElementRenderer {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.used))
+	for name := range r.used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	symbols := make([]ElementRenderer, 0, len(names))
+	for _, name := range names {
+		ic := r.icons[name]
+		symbol := SVG_SYMBOL(ic.content...).ID(prefix + name)
+		symbol.Attr("viewBox", ic.viewBox)
+		symbols = append(symbols, symbol)
+	}
+	r.mu.Unlock()
+
+	if len(symbols) == 0 {
+		return Group()
+	}
+	sheet := SVG_SVG(symbols...).WIDTH("0").HEIGHT("0")
+	sheet.Attr("aria-hidden", "true")
+	sheet.STYLE("display", "none")
+	return sheet
+}
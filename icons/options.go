@@ -0,0 +1,32 @@
+package icons
+
+import (
+	"fmt"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// IconOption adjusts an icon component generated by cmd/iconpack.
+type IconOption func(*SVGSVGElement)
+
+// IconSize sets the rendered width and height in pixels.
+func IconSize(px int) IconOption {
+	return func(svg *SVGSVGElement) {
+		svg.WIDTH(fmt.Sprint(px)).HEIGHT(fmt.Sprint(px))
+	}
+}
+
+// IconStroke sets the stroke width, for packs drawn with strokes (Lucide,
+// Feather).
+func IconStroke(width string) IconOption {
+	return func(svg *SVGSVGElement) {
+		svg.Attr("stroke-width", width)
+	}
+}
+
+// IconClass adds classes to the icon's root svg element.
+func IconClass(classes ...string) IconOption {
+	return func(svg *SVGSVGElement) {
+		svg.CLASS(classes...)
+	}
+}
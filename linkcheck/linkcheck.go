@@ -0,0 +1,109 @@
+// Package linkcheck walks element trees validating the URLs they reference,
+// so broken links surface at build or test time instead of in production.
+// Internal paths are checked against a route predicate; external links are
+// only touched when an http.Client is provided.
+package linkcheck
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/delaneyj/gostar/elements"
+)
+
+// urlAttrs are the attributes that carry link targets.
+var urlAttrs = []string{"href", "src", "action", "formaction", "poster"}
+
+// Broken is one failed link: the URL as written and why it failed.
+type Broken struct {
+	URL    string
+	Reason string
+}
+
+// Option configures a Check run.
+type Option func(*checker)
+
+// WithExternalClient enables HEAD-requesting http(s) links with client; any
+// network error or status >= 400 reports the link broken. Without it
+// external links are skipped.
+func WithExternalClient(client *http.Client) Option {
+	return func(c *checker) {
+		c.client = client
+	}
+}
+
+type checker struct {
+	client *http.Client
+}
+
+// Check walks root and returns the broken links it finds. known reports
+// whether an internal path (leading slash, query and fragment stripped) is
+// routable — wire it to the app's route table. A nil known skips internal
+// validation.
+func Check(root elements.ElementRenderer, known func(path string) bool, opts ...Option) []Broken {
+	c := &checker{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	var (
+		broken []Broken
+		seen   = map[string]bool{}
+	)
+	elements.Walk(root, func(e *elements.Element) bool {
+		if e.StringAttributes == nil {
+			return true
+		}
+		for _, attr := range urlAttrs {
+			u, ok := e.StringAttributes.Get(attr)
+			if !ok || u == "" || seen[u] {
+				continue
+			}
+			seen[u] = true
+			if reason := c.check(u, known); reason != "" {
+				broken = append(broken, Broken{URL: u, Reason: reason})
+			}
+		}
+		return true
+	})
+	return broken
+}
+
+func (c *checker) check(u string, known func(path string) bool) string {
+	switch {
+	case strings.HasPrefix(u, "#"),
+		strings.HasPrefix(u, "mailto:"),
+		strings.HasPrefix(u, "tel:"),
+		strings.HasPrefix(u, "data:"),
+		strings.HasPrefix(u, "javascript:"):
+		return ""
+	case strings.HasPrefix(u, "http://"), strings.HasPrefix(u, "https://"):
+		if c.client == nil {
+			return ""
+		}
+		resp, err := c.client.Head(u)
+		if err != nil {
+			return err.Error()
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 400 {
+			return fmt.Sprintf("status %d", resp.StatusCode)
+		}
+		return ""
+	case strings.HasPrefix(u, "/"):
+		if known == nil {
+			return ""
+		}
+		path := u
+		if i := strings.IndexAny(path, "?#"); i >= 0 {
+			path = path[:i]
+		}
+		if !known(path) {
+			return "no matching route"
+		}
+		return ""
+	default:
+		return "relative URL; use a rooted path"
+	}
+}
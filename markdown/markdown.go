@@ -0,0 +1,283 @@
+// Package markdown converts markdown into a gostar element tree instead of
+// raw HTML, so markdown content participates in the same attribute
+// decoration, escaping, and datastar wiring as the rest of the page. Parsing
+// is delegated to goldmark; only tree construction lives here.
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// Decorator is called for every element built from a markdown node, and may
+// return a replacement renderer (e.g. to add classes, ids, or datastar
+// attributes). Returning el unchanged is the common case.
+type Decorator func(node ast.Node, el ElementRenderer) ElementRenderer
+
+type options struct {
+	md        goldmark.Markdown
+	decorator Decorator
+	unsafe    bool
+}
+
+// Option configures the conversion.
+type Option func(*options)
+
+// WithGoldmark substitutes a custom goldmark instance, e.g. one with
+// extensions enabled. Only its parser is used.
+func WithGoldmark(md goldmark.Markdown) Option {
+	return func(o *options) {
+		o.md = md
+	}
+}
+
+// WithDecorator installs a Decorator applied to every built element.
+func WithDecorator(d Decorator) Option {
+	return func(o *options) {
+		o.decorator = d
+	}
+}
+
+// WithUnsafeHTML passes raw HTML blocks and spans through unescaped and
+// disables the link/image URL scheme filter. By default both are applied,
+// matching goldmark's own safe defaults.
+func WithUnsafeHTML() Option {
+	return func(o *options) {
+		o.unsafe = true
+	}
+}
+
+// ToElement parses source as markdown and returns the equivalent gostar tree.
+func ToElement(source []byte, opts ...Option) (ElementRenderer, error) {
+	o := &options{md: goldmark.New()}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	doc := o.md.Parser().Parse(text.NewReader(source))
+	return o.convertChildren(doc, source)
+}
+
+func (o *options) convertChildren(parent ast.Node, source []byte) (ElementRenderer, error) {
+	children := make([]ElementRenderer, 0, parent.ChildCount())
+	for c := parent.FirstChild(); c != nil; c = c.NextSibling() {
+		el, err := o.convert(c, source)
+		if err != nil {
+			return nil, err
+		}
+		if el != nil {
+			children = append(children, el)
+		}
+	}
+	return Group(children...), nil
+}
+
+func (o *options) convert(n ast.Node, source []byte) (ElementRenderer, error) {
+	var el ElementRenderer
+	switch n := n.(type) {
+	case *ast.Heading:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Level {
+		case 1:
+			el = H1(inner)
+		case 2:
+			el = H2(inner)
+		case 3:
+			el = H3(inner)
+		case 4:
+			el = H4(inner)
+		case 5:
+			el = H5(inner)
+		default:
+			el = H6(inner)
+		}
+	case *ast.Paragraph:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		el = P(inner)
+	case *ast.TextBlock:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		el = inner
+	case *ast.Blockquote:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		el = BLOCKQUOTE(inner)
+	case *ast.List:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		if n.IsOrdered() {
+			ol := OL(inner)
+			if n.Start != 1 && n.Start != 0 {
+				ol.START(n.Start)
+			}
+			el = ol
+		} else {
+			el = UL(inner)
+		}
+	case *ast.ListItem:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		el = LI(inner)
+	case *ast.CodeBlock:
+		el = PRE(CODE().Escaped(string(nodeLines(n, source))))
+	case *ast.FencedCodeBlock:
+		code := CODE().Escaped(string(nodeLines(n, source)))
+		if lang := n.Language(source); lang != nil {
+			code.CLASS("language-" + string(lang))
+		}
+		el = PRE(code)
+	case *ast.ThematicBreak:
+		el = HR()
+	case *ast.Emphasis:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		if n.Level >= 2 {
+			el = STRONG(inner)
+		} else {
+			el = EM(inner)
+		}
+	case *ast.Link:
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, err
+		}
+		a := A(inner).HREF(EscapeF("%s", o.safeURL(string(n.Destination))))
+		if len(n.Title) > 0 {
+			a.TITLE(EscapeF("%s", string(n.Title)))
+		}
+		el = a
+	case *ast.AutoLink:
+		url := string(n.URL(source))
+		label := string(n.Label(source))
+		if n.AutoLinkType == ast.AutoLinkEmail {
+			url = "mailto:" + url
+		}
+		el = A().HREF(EscapeF("%s", o.safeURL(url))).Escaped(label)
+	case *ast.Image:
+		img := IMG().
+			SRC(EscapeF("%s", o.safeURL(string(n.Destination)))).
+			ALT(EscapeF("%s", nodeText(n, source)))
+		if len(n.Title) > 0 {
+			img.TITLE(EscapeF("%s", string(n.Title)))
+		}
+		el = img
+	case *ast.CodeSpan:
+		el = CODE().Escaped(nodeText(n, source))
+	case *ast.RawHTML:
+		segments := n.Segments
+		raw := ""
+		for i := 0; i < segments.Len(); i++ {
+			s := segments.At(i)
+			raw += string(s.Value(source))
+		}
+		if o.unsafe {
+			el = Text(raw)
+		} else {
+			el = Escaped(raw)
+		}
+	case *ast.HTMLBlock:
+		raw := string(nodeLines(n, source))
+		if o.unsafe {
+			el = Text(raw)
+		} else {
+			el = Escaped(raw)
+		}
+	case *ast.Text:
+		parts := []ElementRenderer{Escaped(string(n.Segment.Value(source)))}
+		if n.HardLineBreak() {
+			parts = append(parts, BR())
+		} else if n.SoftLineBreak() {
+			parts = append(parts, Text("\n"))
+		}
+		el = Group(parts...)
+	case *ast.String:
+		el = Escaped(string(n.Value))
+	default:
+		// Nodes from extensions this adapter doesn't know about; render
+		// their children so content isn't silently dropped.
+		inner, err := o.convertChildren(n, source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s: %w", n.Kind(), err)
+		}
+		el = inner
+	}
+
+	if o.decorator != nil && el != nil {
+		el = o.decorator(n, el)
+	}
+	return el, nil
+}
+
+// safeURL filters link and image destinations that could execute script,
+// mirroring goldmark's safe-by-default rendering: relative URLs and the
+// http, https, ftp and mailto schemes pass, anything else (javascript:,
+// data:, vbscript:) collapses to "". WithUnsafeHTML disables the filter
+// along with raw HTML escaping.
+func (o *options) safeURL(dest string) string {
+	if o.unsafe {
+		return dest
+	}
+	for i := 0; i < len(dest); i++ {
+		switch dest[i] {
+		case '/', '?', '#':
+			// Relative: no scheme before the first path delimiter.
+			return dest
+		case ':':
+			switch strings.ToLower(dest[:i]) {
+			case "http", "https", "ftp", "mailto":
+				return dest
+			}
+			return ""
+		}
+	}
+	return dest
+}
+
+// nodeLines concatenates the raw source lines of a block node.
+func nodeLines(n ast.Node, source []byte) []byte {
+	var out []byte
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		s := lines.At(i)
+		out = append(out, s.Value(source)...)
+	}
+	return out
+}
+
+// nodeText flattens the plain text of a node's descendants, used for alt
+// text and code spans.
+func nodeText(n ast.Node, source []byte) string {
+	var out []byte
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch c := c.(type) {
+		case *ast.Text:
+			out = append(out, c.Segment.Value(source)...)
+		case *ast.String:
+			out = append(out, c.Value...)
+		default:
+			out = append(out, nodeText(c, source)...)
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,129 @@
+// Package memo caches rendered fragments by key with stale-while-revalidate
+// semantics: within the TTL a cached fragment is served as-is, after it the
+// stale bytes are still served immediately while one background goroutine
+// re-renders the fragment and swaps it in. Requests never wait on a
+// re-render, at the cost of briefly serving stale markup.
+package memo
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+// Cache holds rendered fragments keyed by string. The zero value is not
+// usable; construct with New.
+type Cache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry
+
+	hits, misses, refreshes atomic.Uint64
+}
+
+type entry struct {
+	html       []byte
+	renderedAt time.Time
+	refreshing bool
+}
+
+// New returns a cache whose fragments are considered fresh for ttl after
+// rendering.
+func New(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: map[string]*entry{}}
+}
+
+// Stats reports lifetime counters: hits (served from cache, fresh or
+// stale), misses (rendered inline because nothing was cached) and refreshes
+// (background re-renders completed).
+func (c *Cache) Stats() (hits, misses, refreshes uint64) {
+	return c.hits.Load(), c.misses.Load(), c.refreshes.Load()
+}
+
+// Invalidate drops the fragment cached under key, forcing the next render
+// to miss. Use it when the underlying data changed and stale is not
+// acceptable.
+func (c *Cache) Invalidate(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
+
+func render(build func() ElementRenderer) ([]byte, error) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := build().Render(buf); err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), buf.Bytes()...), nil
+}
+
+type memoized struct {
+	cache *Cache
+	key   string
+	build func() ElementRenderer
+}
+
+func (m *memoized) Render(w io.Writer) error {
+	c := m.cache
+	c.mu.Lock()
+	e, ok := c.entries[m.key]
+	if !ok {
+		c.mu.Unlock()
+		c.misses.Add(1)
+		html, err := render(m.build)
+		if err != nil {
+			return err
+		}
+		c.mu.Lock()
+		c.entries[m.key] = &entry{html: html, renderedAt: time.Now()}
+		c.mu.Unlock()
+		_, err = w.Write(html)
+		return err
+	}
+
+	html := e.html
+	stale := time.Since(e.renderedAt) > c.ttl
+	if stale && !e.refreshing {
+		e.refreshing = true
+		go c.refresh(m.key, m.build)
+	}
+	c.mu.Unlock()
+
+	c.hits.Add(1)
+	_, err := w.Write(html)
+	return err
+}
+
+// refresh re-renders key's fragment off the request path. On error the
+// stale entry stays in place and the next stale hit retries.
+func (c *Cache) refresh(key string, build func() ElementRenderer) {
+	html, err := render(build)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	e.refreshing = false
+	if err != nil {
+		return
+	}
+	e.html = html
+	e.renderedAt = time.Now()
+	c.refreshes.Add(1)
+}
+
+// Renderer wraps build as a cached fragment under key. The first render
+// pays full price; later renders serve cached bytes, refreshing in the
+// background once the TTL lapses. build must produce equivalent output for
+// the same key — fold anything that varies (locale, role, page) into it.
+func (c *Cache) Renderer(key string, build func() ElementRenderer) ElementRenderer {
+	return &memoized{cache: c, key: key, build: build}
+}
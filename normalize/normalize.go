@@ -0,0 +1,80 @@
+// Package normalize re-serializes arbitrary HTML through gostar's own
+// renderer, so tests can compare gostar output with output from other
+// systems regardless of formatting differences.
+package normalize
+
+import (
+	"strings"
+
+	"github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// voidTags are serialized self-closing the way gostar renders them.
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// Normalize parses fragment (full documents work too; the body content is
+// used) and re-serializes it with gostar conventions: lowercase tags,
+// alphabetically sorted attributes, collapsed inter-tag whitespace. Two
+// fragments are semantically equal iff their normalized forms are equal.
+func Normalize(fragment string) (string, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	wrapper := elements.DIV()
+	for _, n := range nodes {
+		if el := convert(n); el != nil {
+			wrapper.Children(el)
+		}
+	}
+	elements.CollapseWhitespace(wrapper)
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	for _, el := range wrapper.Descendants {
+		if err := el.Render(buf); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+func convert(n *html.Node) elements.ElementRenderer {
+	switch n.Type {
+	case html.TextNode:
+		return elements.Escaped(n.Data)
+	case html.ElementNode:
+		el := &elements.Element{
+			Tag:           []byte(strings.ToLower(n.Data)),
+			IsSelfClosing: voidTags[strings.ToLower(n.Data)],
+		}
+		for _, a := range n.Attr {
+			key := strings.ToLower(a.Key)
+			if a.Namespace != "" {
+				key = a.Namespace + ":" + key
+			}
+			el.Attr(key, a.Val)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if child := convert(c); child != nil {
+				el.Descendants = append(el.Descendants, child)
+			}
+		}
+		return el
+	default:
+		// Comments and doctypes don't affect semantic equality.
+		return nil
+	}
+}
@@ -0,0 +1,82 @@
+// Package push scaffolds Web Push subscriptions: the service-worker
+// registration script, a subscribe button driven by signals, and the
+// server-side endpoint that receives the subscription JSON. Sending the
+// actual notifications is left to a webpush library; this package only gets
+// the subscription into your hands.
+package push
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+// RegisterServiceWorker renders the inline script registering the service
+// worker at path (usually "/sw.js"), guarded by feature detection. Place it
+// once in the page head.
+func RegisterServiceWorker(path string) ElementRenderer {
+	return SCRIPT(Text(fmt.Sprintf(
+		"if ('serviceWorker' in navigator) { navigator.serviceWorker.register('%s') }",
+		path)))
+}
+
+// SubscribeButton renders a button that subscribes the browser to push
+// notifications and posts the subscription JSON to endpoint. Success flips
+// $pushSubscribed (hide the button with data-show="!$pushSubscribed");
+// failures land in $pushError.
+func SubscribeButton(vapidPublicKey, endpoint string, children ...ElementRenderer) *BUTTONElement {
+	if len(children) == 0 {
+		children = []ElementRenderer{Text("Enable notifications")}
+	}
+	subscribe := fmt.Sprintf(
+		"navigator.serviceWorker.ready"+
+			".then((reg) => reg.pushManager.subscribe({userVisibleOnly: true, applicationServerKey: '%s'}))"+
+			".then((sub) => fetch('%s', {method: 'POST', headers: {'Content-Type': 'application/json'}, body: JSON.stringify(sub)}))"+
+			".then(() => { $pushSubscribed = true })"+
+			".catch((err) => { $pushError = err.message })",
+		vapidPublicKey, endpoint)
+	btn := BUTTON().
+		TYPE("button").
+		DATASTAR_ON("click", subscribe)
+	return btn.Children(children...)
+}
+
+// Subscription is the browser's PushSubscription as posted by
+// SubscribeButton, ready to hand to a webpush sender.
+type Subscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256DH string `json:"p256dh"`
+		Auth   string `json:"auth"`
+	} `json:"keys"`
+}
+
+// SubscriptionHandler returns the endpoint receiving subscription JSON from
+// SubscribeButton. store persists it keyed however the app likes; a store
+// error surfaces as a 500 so the button's catch fires.
+func SubscriptionHandler(store func(r *http.Request, sub *Subscription) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 16*1024))
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		sub := &Subscription{}
+		if err := json.Unmarshal(body, sub); err != nil || sub.Endpoint == "" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if err := store(r, sub); err != nil {
+			http.Error(w, "store failed", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
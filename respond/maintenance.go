@@ -0,0 +1,32 @@
+package respond
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/delaneyj/gostar/elements"
+)
+
+// Maintenance wraps next with a maintenance-mode gate: while enabled reports
+// true every request short-circuits to page with a 503 and a Retry-After of
+// retryAfter, so crawlers and load balancers back off instead of caching the
+// outage. A nil page falls back to components.MaintenancePage. enabled is
+// called per request — point it at a flag so maintenance toggles without a
+// restart.
+func Maintenance(next http.Handler, enabled func() bool, retryAfter time.Duration, page elements.ElementRenderer) http.Handler {
+	if page == nil {
+		page = components.MaintenancePage("")
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		}
+		Error(w, http.StatusServiceUnavailable, page)
+	})
+}
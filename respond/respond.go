@@ -0,0 +1,99 @@
+// Package respond lets one handler serve browsers, datastar requests and API
+// clients from the same data. Negotiate inspects the Datastar and Accept
+// headers to pick a representation; Write renders the matching branch of a
+// Response — full page, SSE element patch, JSON, or a plain-text rendering
+// of the page for curl.
+package respond
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/email"
+	"github.com/delaneyj/gostar/sse"
+)
+
+// Kind is the representation a request asked for.
+type Kind int
+
+const (
+	// KindPage is a full HTML document for a plain browser navigation.
+	KindPage Kind = iota
+	// KindPatch is an SSE datastar-patch-elements stream for an in-page
+	// datastar action.
+	KindPatch
+	// KindJSON is the raw data for API clients.
+	KindJSON
+	// KindText is a plain-text rendering of the page for curl and other
+	// CLI consumers.
+	KindText
+)
+
+// Negotiate picks the representation for r: datastar requests (the
+// Datastar-Request header or an event-stream Accept) get a patch, clients
+// accepting JSON but not HTML get JSON, a text/plain Accept or ?format=txt
+// gets plain text, everything else gets the page.
+func Negotiate(r *http.Request) Kind {
+	accept := r.Header.Get("Accept")
+	if r.Header.Get("Datastar-Request") == "true" || strings.Contains(accept, "text/event-stream") {
+		return KindPatch
+	}
+	if strings.Contains(accept, "text/html") {
+		return KindPage
+	}
+	if strings.Contains(accept, "application/json") {
+		return KindJSON
+	}
+	if r.URL.Query().Get("format") == "txt" || strings.Contains(accept, "text/plain") {
+		return KindText
+	}
+	return KindPage
+}
+
+// Response carries the representations a handler can serve. Page is
+// required; Fragment defaults to Page when only part of the page differs per
+// request; a nil JSON falls back to the page so API clients still get an
+// answer.
+type Response struct {
+	// Page is the full document rendered for browser navigations.
+	Page elements.ElementRenderer
+	// Fragment is what a datastar action patches in; defaults to Page.
+	Fragment elements.ElementRenderer
+	// JSON is marshalled for API clients accepting application/json.
+	JSON any
+}
+
+// Write renders the representation Negotiate picked for r.
+func Write(w http.ResponseWriter, r *http.Request, resp Response) error {
+	switch Negotiate(r) {
+	case KindPatch:
+		fragment := resp.Fragment
+		if fragment == nil {
+			fragment = resp.Page
+		}
+		return sse.New(w).PatchElements(fragment)
+	case KindJSON:
+		if resp.JSON != nil {
+			w.Header().Set("Content-Type", "application/json")
+			return json.NewEncoder(w).Encode(resp.JSON)
+		}
+	case KindText:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, err := io.WriteString(w, email.Plaintext(resp.Page)+"\n")
+		return err
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	return resp.Page.Render(w)
+}
+
+// Error writes page with the given status code, e.g.
+// components.NotFoundPage with 404. It replaces http.Error on handler error
+// paths so error responses share the site's markup.
+func Error(w http.ResponseWriter, status int, page elements.ElementRenderer) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	return page.Render(w)
+}
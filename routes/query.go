@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Param is one query parameter for URLWith.
+type Param struct {
+	Key   string
+	Value any
+}
+
+// Q builds a query parameter; the value is formatted with fmt.
+func Q(key string, value any) Param {
+	return Param{Key: key, Value: value}
+}
+
+// URLWith appends query parameters to base with proper encoding and keys in
+// sorted order, so the same inputs always produce the same URL — pagination
+// links, data-grid fetches and datastar @get actions stay cache-friendly.
+// Parameters already present on base are kept; repeated keys accumulate.
+func URLWith(base string, params ...Param) string {
+	path := base
+	var fragment string
+	if i := strings.Index(path, "#"); i >= 0 {
+		path, fragment = path[:i], path[i:]
+	}
+	query := url.Values{}
+	if i := strings.Index(path, "?"); i >= 0 {
+		if parsed, err := url.ParseQuery(path[i+1:]); err == nil {
+			query = parsed
+		}
+		path = path[:i]
+	}
+	for _, p := range params {
+		query.Add(p.Key, fmt.Sprint(p.Value))
+	}
+	if len(query) == 0 {
+		return path + fragment
+	}
+	return path + "?" + query.Encode() + fragment
+}
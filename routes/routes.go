@@ -0,0 +1,87 @@
+// Package routes builds URLs from named route patterns, so links are
+// declared once and hand-built path strings disappear. The resulting strings
+// go straight into HREF/ACTION/SRC setters, and Known plugs the table into
+// the linkcheck pass.
+package routes
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Table maps route names to path patterns with ":param" segments.
+type Table struct {
+	patterns map[string]string
+}
+
+// New returns an empty route table.
+func New() *Table {
+	return &Table{patterns: map[string]string{}}
+}
+
+// Add registers name for pattern, e.g. Add("user.orders", "/users/:id/orders").
+// Redefining a name panics: routes are program structure, not data.
+func (t *Table) Add(name, pattern string) *Table {
+	if _, exists := t.patterns[name]; exists {
+		panic(fmt.Sprintf("routes: %q already defined", name))
+	}
+	t.patterns[name] = pattern
+	return t
+}
+
+// URL builds the path for name, filling ":param" segments with params in
+// order. Params are formatted with fmt and path-escaped. A missing name or a
+// param count mismatch panics, since both are bugs a test hits immediately.
+func (t *Table) URL(name string, params ...any) string {
+	pattern, ok := t.patterns[name]
+	if !ok {
+		panic(fmt.Sprintf("routes: unknown route %q", name))
+	}
+	segments := strings.Split(pattern, "/")
+	next := 0
+	for i, seg := range segments {
+		if !strings.HasPrefix(seg, ":") {
+			continue
+		}
+		if next >= len(params) {
+			panic(fmt.Sprintf("routes: %q needs more params than the %d given", name, len(params)))
+		}
+		segments[i] = url.PathEscape(fmt.Sprint(params[next]))
+		next++
+	}
+	if next != len(params) {
+		panic(fmt.Sprintf("routes: %q takes %d params, got %d", name, next, len(params)))
+	}
+	return strings.Join(segments, "/")
+}
+
+// Known reports whether path matches any registered pattern, with ":param"
+// segments matching any non-empty value. Pass it to linkcheck.Check.
+func (t *Table) Known(path string) bool {
+	got := strings.Split(path, "/")
+	for _, pattern := range t.patterns {
+		want := strings.Split(pattern, "/")
+		if len(want) != len(got) {
+			continue
+		}
+		match := true
+		for i := range want {
+			if strings.HasPrefix(want[i], ":") {
+				if got[i] == "" {
+					match = false
+					break
+				}
+				continue
+			}
+			if want[i] != got[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package sse
+
+import (
+	"io"
+	"strings"
+	"sync"
+)
+
+// Recorder is a Generator that captures every event as a structured value, so
+// handler tests can verify patches without parsing raw wire text. Hand the
+// embedded Generator to the code under test and assert on the recorded
+// events.
+type Recorder struct {
+	*Generator
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewRecorder returns a Recorder whose Generator discards the wire output and
+// records the events instead.
+func NewRecorder() *Recorder {
+	r := &Recorder{}
+	r.Generator = &Generator{w: io.Discard}
+	r.Generator.onEvent = func(evt Event) {
+		r.mu.Lock()
+		r.events = append(r.events, evt)
+		r.mu.Unlock()
+	}
+	return r
+}
+
+// Events returns a copy of all recorded events in order.
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+// EventsOfType returns the recorded events with the given event type.
+func (r *Recorder) EventsOfType(eventType string) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := []Event{}
+	for _, evt := range r.events {
+		if evt.Type == eventType {
+			out = append(out, evt)
+		}
+	}
+	return out
+}
+
+// PatchedElements returns the rendered HTML payload of each
+// datastar-patch-elements event, with the `elements ` data prefixes stripped
+// and lines rejoined.
+func (r *Recorder) PatchedElements() []string {
+	out := []string{}
+	for _, evt := range r.EventsOfType(EventTypePatchElements) {
+		lines := []string{}
+		for _, d := range evt.Data {
+			if rest, ok := strings.CutPrefix(d, "elements "); ok {
+				lines = append(lines, rest)
+			}
+		}
+		out = append(out, strings.Join(lines, "\n"))
+	}
+	return out
+}
+
+// PatchedSignals returns the signals JSON payload of each
+// datastar-patch-signals event.
+func (r *Recorder) PatchedSignals() []string {
+	out := []string{}
+	for _, evt := range r.EventsOfType(EventTypePatchSignals) {
+		lines := []string{}
+		for _, d := range evt.Data {
+			if rest, ok := strings.CutPrefix(d, "signals "); ok {
+				lines = append(lines, rest)
+			}
+		}
+		out = append(out, strings.Join(lines, "\n"))
+	}
+	return out
+}
+
+// TB is the sliver of testing.TB the assert helpers use, kept local so
+// importing sse does not link the testing package into production binaries.
+// A *testing.T satisfies it.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// AssertPatchedElements fails the test unless some patch-elements event's
+// HTML contains substr.
+func (r *Recorder) AssertPatchedElements(tb TB, substr string) {
+	tb.Helper()
+	patches := r.PatchedElements()
+	for _, p := range patches {
+		if strings.Contains(p, substr) {
+			return
+		}
+	}
+	tb.Fatalf("no patch-elements event contains %q (got %d patches: %v)", substr, len(patches), patches)
+}
+
+// AssertPatchedSignals fails the test unless some patch-signals event's JSON
+// contains substr.
+func (r *Recorder) AssertPatchedSignals(tb TB, substr string) {
+	tb.Helper()
+	patches := r.PatchedSignals()
+	for _, p := range patches {
+		if strings.Contains(p, substr) {
+			return
+		}
+	}
+	tb.Fatalf("no patch-signals event contains %q (got %d patches: %v)", substr, len(patches), patches)
+}
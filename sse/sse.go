@@ -0,0 +1,151 @@
+// Package sse implements the server-sent events wire format used by datastar,
+// so gostar trees can be patched into live pages without hand-writing
+// `event:`/`data:` lines.
+package sse
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+// Datastar event types understood by the browser runtime.
+const (
+	EventTypePatchElements = "datastar-patch-elements"
+	EventTypePatchSignals  = "datastar-patch-signals"
+)
+
+// Event is a single server-sent event. Data lines are written one `data:`
+// line each, per the SSE specification.
+type Event struct {
+	Type  string
+	ID    string
+	Retry time.Duration
+	Data  []string
+}
+
+// Generator writes events to an http.ResponseWriter (or any io.Writer),
+// flushing after each event so they reach the browser immediately. It is safe
+// for concurrent use.
+type Generator struct {
+	mu      sync.Mutex
+	w       io.Writer
+	flusher http.Flusher
+	onEvent func(Event)
+}
+
+// New prepares w for an SSE stream (content type, cache headers) and returns a
+// Generator writing to it.
+func New(w http.ResponseWriter) *Generator {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	flusher, _ := w.(http.Flusher)
+	return &Generator{w: w, flusher: flusher}
+}
+
+// NewWriter returns a Generator writing to an arbitrary writer, useful for
+// tests and buffering.
+func NewWriter(w io.Writer) *Generator {
+	return &Generator{w: w}
+}
+
+// Send writes a single event to the stream.
+func (g *Generator) Send(evt Event) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.onEvent != nil {
+		g.onEvent(evt)
+	}
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	if evt.Type != "" {
+		fmt.Fprintf(buf, "event: %s\n", evt.Type)
+	}
+	if evt.ID != "" {
+		fmt.Fprintf(buf, "id: %s\n", evt.ID)
+	}
+	if evt.Retry > 0 {
+		fmt.Fprintf(buf, "retry: %d\n", evt.Retry.Milliseconds())
+	}
+	for _, d := range evt.Data {
+		fmt.Fprintf(buf, "data: %s\n", d)
+	}
+	buf.WriteString("\n")
+
+	if _, err := g.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if g.flusher != nil {
+		g.flusher.Flush()
+	}
+	return nil
+}
+
+// PatchElementsOption tweaks how the browser applies a patch.
+type PatchElementsOption func(*patchElementsOptions)
+
+type patchElementsOptions struct {
+	selector string
+	mode     string
+}
+
+// WithSelector targets the patch at a CSS selector instead of matching by id.
+func WithSelector(selector string) PatchElementsOption {
+	return func(o *patchElementsOptions) {
+		o.selector = selector
+	}
+}
+
+// WithMode sets the patch mode (e.g. "inner", "append", "prepend", "remove").
+func WithMode(mode string) PatchElementsOption {
+	return func(o *patchElementsOptions) {
+		o.mode = mode
+	}
+}
+
+// PatchElements renders el and sends it as a datastar-patch-elements event.
+func (g *Generator) PatchElements(el elements.ElementRenderer, opts ...PatchElementsOption) error {
+	o := &patchElementsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := el.Render(buf); err != nil {
+		return fmt.Errorf("failed to render patch elements: %w", err)
+	}
+
+	data := make([]string, 0, 4)
+	if o.selector != "" {
+		data = append(data, "selector "+o.selector)
+	}
+	if o.mode != "" {
+		data = append(data, "mode "+o.mode)
+	}
+	for _, line := range strings.Split(buf.String(), "\n") {
+		data = append(data, "elements "+line)
+	}
+
+	return g.Send(Event{Type: EventTypePatchElements, Data: data})
+}
+
+// PatchSignals sends a datastar-patch-signals event with the given signals
+// JSON, e.g. `{"count": 1}`.
+func (g *Generator) PatchSignals(signalsJSON string) error {
+	data := make([]string, 0, 1)
+	for _, line := range strings.Split(signalsJSON, "\n") {
+		data = append(data, "signals "+line)
+	}
+	return g.Send(Event{Type: EventTypePatchSignals, Data: data})
+}
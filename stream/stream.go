@@ -0,0 +1,123 @@
+// Package stream renders pages with slow data dependencies out of order.
+// Placeholders render a fallback immediately so the shell reaches the
+// browser fast, while their real content resolves in goroutines; once the
+// shell is written the resolved slots are streamed after it in completion
+// order, either as inline template+script swaps on the same response or as
+// datastar patch-elements over SSE.
+package stream
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+)
+
+type resolved struct {
+	id      string
+	content ElementRenderer
+	err     error
+}
+
+// Streamer tracks the placeholders of a single page render. Create one per
+// request.
+type Streamer struct {
+	mu      sync.Mutex
+	pending int
+	results chan resolved
+}
+
+// New returns a Streamer for one page render.
+func New() *Streamer {
+	return &Streamer{results: make(chan resolved, 16)}
+}
+
+type placeholder struct {
+	s        *Streamer
+	id       string
+	fallback ElementRenderer
+	resolve  func() (ElementRenderer, error)
+}
+
+func (p *placeholder) Render(w io.Writer) error {
+	p.s.mu.Lock()
+	p.s.pending++
+	p.s.mu.Unlock()
+	go func() {
+		content, err := p.resolve()
+		p.s.results <- resolved{id: p.id, content: content, err: err}
+	}()
+
+	slot := DIV().ID(p.id)
+	if p.fallback != nil {
+		slot.Children(p.fallback)
+	}
+	return slot.Render(w)
+}
+
+// Placeholder renders fallback inside a div with the given id and starts
+// resolve in a goroutine when the shell render reaches it. The div is the
+// swap target, so id must be unique in the page.
+func (s *Streamer) Placeholder(id string, fallback ElementRenderer, resolve func() (ElementRenderer, error)) ElementRenderer {
+	return &placeholder{s: s, id: id, fallback: fallback, resolve: resolve}
+}
+
+// drain hands each resolved slot to emit in completion order, returning the
+// first resolve error after all slots have finished.
+func (s *Streamer) drain(emit func(id string, content ElementRenderer) error) error {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = 0
+	s.mu.Unlock()
+
+	var firstErr error
+	for i := 0; i < pending; i++ {
+		r := <-s.results
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("resolving slot %q: %w", r.id, r.err)
+			}
+			continue
+		}
+		if err := emit(r.id, r.content); err != nil {
+			return err
+		}
+	}
+	return firstErr
+}
+
+// StreamScripts writes each resolved slot to w as it completes, as a
+// template plus an inline script that swaps it into the placeholder div,
+// flushing after each so the browser applies swaps as they arrive. Call it
+// after the shell has rendered to the same writer; a slot whose resolve
+// failed keeps its fallback and the first such error is returned at the
+// end.
+func (s *Streamer) StreamScripts(w io.Writer) error {
+	fl, _ := WriterAs[interface{ Flush() }](w)
+	return s.drain(func(id string, content ElementRenderer) error {
+		tmpl := NewElement("template", content)
+		tmpl.Attr("id", "gostar-slot-"+id)
+		script := SCRIPT(Text(fmt.Sprintf(
+			`(function(){var t=document.getElementById('gostar-slot-%s');document.getElementById('%s').replaceWith(t.content);t.remove();})()`,
+			id, id)))
+		if err := Group(tmpl, script).Render(w); err != nil {
+			return err
+		}
+		if fl != nil {
+			fl.Flush()
+		}
+		return nil
+	})
+}
+
+// StreamPatches sends each resolved slot over gen as a
+// datastar-patch-elements event targeting the placeholder div by id. Use it
+// when the page holds an SSE connection open instead of sharing the
+// document response.
+func (s *Streamer) StreamPatches(gen *sse.Generator) error {
+	return s.drain(func(id string, content ElementRenderer) error {
+		return gen.PatchElements(DIV(content).ID(id))
+	})
+}
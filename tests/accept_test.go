@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/forms"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptBuilder(t *testing.T) {
+	assert.Equal(t, "image/*", forms.AcceptImages().String())
+	assert.Equal(t, ".csv", forms.AcceptExt("csv").String())
+	assert.Equal(t,
+		"image/*,application/pdf,.docx",
+		forms.AcceptImages().And(forms.AcceptMIME("application/pdf"), forms.AcceptExt(".docx")).String())
+
+	run(t, []result{
+		{
+			Expected: `<input accept="image/*,video/*" name="media" type="file" >`,
+			Actual: forms.FileInput("media",
+				forms.WithAccept(forms.AcceptImages().And(forms.AcceptVideo())),
+			),
+		},
+	})
+}
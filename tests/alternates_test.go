@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHreflangAlternates(t *testing.T) {
+	links, err := i18n.HreflangAlternates(map[string]string{
+		"en":    "https://example.com/en/pricing",
+		"de-DE": "https://example.com/de/pricing",
+	}, "https://example.com/pricing")
+	assert.NoError(t, err)
+
+	var sb strings.Builder
+	assert.NoError(t, links.Render(&sb))
+	assert.Equal(t,
+		`<link href="https://example.com/de/pricing" hreflang="de-DE" rel="alternate" >`+
+			`<link href="https://example.com/en/pricing" hreflang="en" rel="alternate" >`+
+			`<link href="https://example.com/pricing" hreflang="x-default" rel="alternate" >`,
+		sb.String())
+}
+
+func TestHreflangAlternatesInvalidCode(t *testing.T) {
+	_, err := i18n.HreflangAlternates(map[string]string{
+		"not a locale": "https://example.com/",
+	}, "")
+	assert.ErrorContains(t, err, `invalid hreflang code "not a locale"`)
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/analytics"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestAnalyticsConsentGating(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<head><script data-domain="example.com" defer ` +
+				`src="https://plausible.io/js/script.js"></script></head>`,
+			Actual: analytics.WithConsent(true,
+				HEAD(analytics.Plausible("example.com"))),
+		},
+		{
+			Expected: `<head></head>`,
+			Actual: analytics.WithConsent(false,
+				HEAD(analytics.Plausible("example.com"))),
+		},
+		{
+			// No WithConsent wrapper means no consent.
+			Expected: ``,
+			Actual:   analytics.Umami("https://stats.example.com/script.js", "abc123"),
+		},
+		{
+			Expected: `<script async src="https://www.googletagmanager.com/gtag/js?id=G-XYZ"></script>` +
+				`<script>window.dataLayer = window.dataLayer || [];` +
+				`function gtag(){dataLayer.push(arguments);}` +
+				`gtag('js', new Date());gtag('config', 'G-XYZ');</script>`,
+			Actual: analytics.WithConsent(true, analytics.GA4("G-XYZ")),
+		},
+	})
+}
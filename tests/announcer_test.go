@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/delaneyj/gostar/sse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnouncer(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div aria-atomic="true" aria-live="polite" id="gostar-announcer" ` +
+				`style="clip:rect(0 0 0 0);height:1px;overflow:hidden;position:absolute;white-space:nowrap;width:1px"></div>`,
+			Actual: components.Announcer(),
+		},
+	})
+
+	rec := sse.NewRecorder()
+	assert.NoError(t, components.Announce(rec.Generator, "3 results found", components.Polite))
+	rec.AssertPatchedElements(t, "3 results found")
+
+	evts := rec.EventsOfType(sse.EventTypePatchElements)
+	assert.Equal(t, "selector #gostar-announcer", evts[0].Data[0])
+	assert.Equal(t, "mode inner", evts[0].Data[1])
+}
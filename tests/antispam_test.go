@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/delaneyj/gostar/forms"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAntiSpamFields(t *testing.T) {
+	served := time.Date(2024, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	run(t, []result{
+		{
+			Expected: `<div aria-hidden="true" style="display:none">` +
+				`<input autocomplete="off" name="website" tabindex="-1" type="text" ></div>`,
+			Actual: forms.Honeypot("website"),
+		},
+		{
+			Expected: `<input name="_form_ts" type="hidden" value="1709812800" >`,
+			Actual:   forms.TimeTrap(served),
+		},
+	})
+
+	assert.NoError(t, forms.CheckHoneypot(url.Values{}, "website"))
+	assert.Error(t, forms.CheckHoneypot(url.Values{"website": {"http://spam"}}, "website"))
+
+	form := url.Values{forms.TimeTrapField: {"1709812800"}}
+	assert.NoError(t, forms.CheckTimeTrap(form, 3*time.Second, served.Add(10*time.Second)))
+	assert.Error(t, forms.CheckTimeTrap(form, 3*time.Second, served.Add(time.Second)))
+	assert.Error(t, forms.CheckTimeTrap(url.Values{}, 3*time.Second, served))
+	assert.Error(t, forms.CheckTimeTrap(url.Values{forms.TimeTrapField: {"soon"}}, 3*time.Second, served))
+}
@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestAttributeOrderIsAlphabetical(t *testing.T) {
+	// Same attributes set in two different orders render identically, which
+	// keeps stored snapshots and cached variants diff-stable.
+	run(t, []result{
+		{
+			Expected: `<div class="card" id="a" lang="en" title="t"></div>`,
+			Actual:   DIV().TITLE("t").LANG("en").ID("a").CLASS("card"),
+		},
+		{
+			Expected: `<div class="card" id="a" lang="en" title="t"></div>`,
+			Actual:   DIV().CLASS("card").ID("a").LANG("en").TITLE("t"),
+		},
+		{
+			Expected: `<input name="q" tabindex="2" type="text" value="x" >`,
+			Actual:   INPUT().VALUE("x").TABINDEX(2).NAME("q").TYPE(InputType_text),
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/auth"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireRole(t *testing.T) {
+	page := func(id auth.Identity) string {
+		var sb strings.Builder
+		root := auth.WithIdentity(id, DIV(
+			auth.RequireRole("admin", A(Text("Admin")).HREF("/admin"), nil),
+			auth.RequireAuthenticated(
+				SPAN(Text("Account")),
+				A(Text("Sign in")).HREF("/login"),
+			),
+		))
+		assert.NoError(t, root.Render(&sb))
+		return sb.String()
+	}
+
+	admin := page(auth.Identity{Subject: "u1", Roles: []string{"admin"}})
+	assert.Contains(t, admin, `<a href="/admin">Admin</a>`)
+	assert.Contains(t, admin, "<span>Account</span>")
+
+	member := page(auth.Identity{Subject: "u2", Roles: []string{"member"}})
+	assert.NotContains(t, member, "Admin")
+	assert.Contains(t, member, "<span>Account</span>")
+
+	anon := page(auth.Identity{})
+	assert.NotContains(t, anon, "Admin")
+	assert.Contains(t, anon, `<a href="/login">Sign in</a>`)
+
+	// Outside WithIdentity every guard falls back.
+	var sb strings.Builder
+	assert.NoError(t, auth.RequireRole("admin", SPAN(Text("x")), Text("no")).Render(&sb))
+	assert.Equal(t, "no", sb.String())
+}
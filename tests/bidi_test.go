@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/i18n"
+)
+
+func TestBidiHelpers(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<bdi>اسم &lt;المستخدم&gt;</bdi>`,
+			Actual:   i18n.BdiText("اسم <المستخدم>"),
+		},
+		{
+			Expected: `<span dir="auto" style="unicode-bidi:isolate">` +
+				`<strong>שלום</strong></span>`,
+			Actual: i18n.Isolate(STRONG().Text("שלום")),
+		},
+		{
+			Expected: `<div><p dir="auto">مرحبا</p><p></p></div>`,
+			Actual:   i18n.AutoDir(DIV(P().Escaped("مرحبا"), P())),
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/auth"
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingRenderer struct{}
+
+func (failingRenderer) Render(w io.Writer) error {
+	return errors.New("boom")
+}
+
+func TestErrorBoundary(t *testing.T) {
+	var trippedID string
+	var trippedErr error
+
+	run(t, []result{
+		{
+			Expected: `<section><p>fine</p></section>`,
+			Actual:   components.ErrorBoundary("widget", SECTION(P().Text("fine"))),
+		},
+		{
+			Expected: `<div class="gostar-error-boundary" id="widget"><p>Something went wrong.</p><button data-on:click="@get('/widget')" type="button">Retry</button></div>`,
+			Actual: components.ErrorBoundary("widget", failingRenderer{},
+				components.WithRetryURL("/widget"),
+				components.WithOnError(func(id string, err error) {
+					trippedID = id
+					trippedErr = err
+				}),
+			),
+		},
+		{
+			Expected: `<div class="gostar-error-boundary" id="widget"><em>unavailable</em></div>`,
+			Actual: components.ErrorBoundary("widget", failingRenderer{},
+				components.WithFallback(func(err error) ElementRenderer {
+					return EM().Text("unavailable")
+				}),
+			),
+		},
+	})
+
+	assert.Equal(t, "widget", trippedID)
+	assert.EqualError(t, trippedErr, "boom")
+}
+
+func TestErrorBoundaryKeepsOuterContext(t *testing.T) {
+	// The boundary buffers its child, but must not hide the render context
+	// installed outside it: guards inside still see the identity.
+	var sb strings.Builder
+	root := auth.WithIdentity(auth.Identity{Subject: "u1", Roles: []string{"admin"}},
+		components.ErrorBoundary("widget",
+			auth.RequireRole("admin", SPAN(Text("admin")), Text("anon")),
+		),
+	)
+	assert.NoError(t, root.Render(&sb))
+	assert.Equal(t, "<span>admin</span>", sb.String())
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreadcrumbs(t *testing.T) {
+	trail := []components.Crumb{
+		{Label: "Home", HREF: "/"},
+		{Label: "Docs", HREF: "/docs"},
+		{Label: "Rendering"},
+	}
+
+	var sb strings.Builder
+	assert.NoError(t, components.Breadcrumbs(trail).Render(&sb))
+	out := sb.String()
+	assert.Contains(t, out, `<nav aria-label="Breadcrumb">`)
+	assert.Contains(t, out, `<li><a href="/docs">Docs</a></li>`)
+	assert.Contains(t, out, `<li><span aria-current="page">Rendering</span></li>`)
+	assert.NotContains(t, out, "ld+json")
+
+	// Labels are content-derived: markup in them renders escaped.
+	sb.Reset()
+	assert.NoError(t, components.Breadcrumbs([]components.Crumb{
+		{Label: "Posts", HREF: "/posts"},
+		{Label: "<script>alert(1)</script>"},
+	}).Render(&sb))
+	assert.NotContains(t, sb.String(), "<script>")
+	assert.Contains(t, sb.String(), "&lt;script&gt;alert(1)&lt;/script&gt;")
+}
+
+func TestBreadcrumbJSONLD(t *testing.T) {
+	trail := []components.Crumb{
+		{Label: "Home", HREF: "/"},
+		{Label: "Docs"},
+	}
+
+	var sb strings.Builder
+	assert.NoError(t, components.Breadcrumbs(trail, components.WithBreadcrumbJSONLD()).Render(&sb))
+	out := sb.String()
+
+	start := strings.Index(out, `<script type="application/ld+json">`)
+	assert.Greater(t, start, 0)
+	payload := out[start+len(`<script type="application/ld+json">`) : strings.LastIndex(out, "</script>")]
+	assert.JSONEq(t, `{
+		"@context": "https://schema.org",
+		"@type": "BreadcrumbList",
+		"itemListElement": [
+			{"@type": "ListItem", "position": 1, "name": "Home", "item": "/"},
+			{"@type": "ListItem", "position": 2, "name": "Docs"}
+		]
+	}`, payload)
+}
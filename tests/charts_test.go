@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/charts"
+)
+
+func TestCharts(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<svg height="24" role="img" viewBox="0 0 120 24" width="120">` +
+				`<polyline fill="none" points="0.00,24.00 60.00,0.00 120.00,12.00" stroke="currentColor"></polyline></svg>`,
+			Actual: charts.Sparkline([]float64{1, 3, 2}),
+		},
+		{
+			Expected: `<svg height="20" role="img" viewBox="0 0 40 20" width="40">` +
+				`<title>requests</title><desc>Requests per day</desc>` +
+				`<rect fill="currentColor" height="10" width="19" x="0" y="10"></rect>` +
+				`<rect fill="currentColor" height="20" width="19" x="20" y="0"></rect></svg>`,
+			Actual: charts.Bar([]float64{2, 4}, 40, 20,
+				charts.WithChartTitle("requests", "Requests per day")),
+		},
+		{
+			Expected: `<line stroke="currentColor" x1="0" x2="100" y1="40" y2="40"></line>`,
+			Actual:   charts.Axis(100, 40, false),
+		},
+		{
+			Expected: `<svg class="spark" height="24" role="img" viewBox="0 0 120 24" width="120"></svg>`,
+			Actual:   charts.Sparkline(nil, charts.WithChartClass("spark")),
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestChildrenManipulation(t *testing.T) {
+	prepended := UL(LI(Text("b")), LI(Text("c")))
+	prepended.PrependChildren(LI(Text("a")))
+
+	inserted := UL(LI(Text("a")), LI(Text("c")))
+	inserted.InsertChildAt(1, LI(Text("b")))
+
+	clamped := UL(LI(Text("a")))
+	clamped.InsertChildAt(99, LI(Text("z")))
+
+	old := LI(Text("old"))
+	replaced := UL(old, LI(Text("kept")))
+	replaced.ReplaceChild(old, LI(Text("new")))
+
+	run(t, []result{
+		{
+			Expected: `<ul><li>a</li><li>b</li><li>c</li></ul>`,
+			Actual:   prepended,
+		},
+		{
+			Expected: `<ul><li>a</li><li>b</li><li>c</li></ul>`,
+			Actual:   inserted,
+		},
+		{
+			Expected: `<ul><li>a</li><li>z</li></ul>`,
+			Actual:   clamped,
+		},
+		{
+			Expected: `<ul><li>new</li><li>kept</li></ul>`,
+			Actual:   replaced,
+		},
+	})
+}
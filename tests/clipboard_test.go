@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/delaneyj/gostar/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClipboardExpressions(t *testing.T) {
+	assert.Equal(t,
+		`navigator.clipboard.writeText('hello').then(() => { $copied = true })`,
+		expr.CopyToClipboard("hello", "copied"))
+	assert.Equal(t,
+		`navigator.clipboard.writeText('it\'s').then(() => { $copied = true })`,
+		expr.CopyToClipboard("it's", "copied"))
+	assert.Equal(t,
+		`if (navigator.share) { navigator.share({title: 'Post', url: 'https://ex.io/p/1'}) } `+
+			`else { navigator.clipboard.writeText('https://ex.io/p/1').then(() => { $shared = true }) }`,
+		expr.Share("Post", "https://ex.io/p/1", "shared"))
+}
+
+func TestCopyAndShareButtons(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<button data-attr:aria-label="$copied ? 'Copied' : 'Copy to clipboard'" ` +
+				`data-on:click="navigator.clipboard.writeText('npm i gostar').then(() => { $copied = true })" ` +
+				`type="button">Copy</button>`,
+			Actual: components.CopyButton("npm i gostar"),
+		},
+		{
+			Expected: `<button aria-label="Share" ` +
+				`data-on:click="if (navigator.share) { navigator.share({title: 'Post', url: '/p/1'}) } ` +
+				`else { navigator.clipboard.writeText('/p/1').then(() => { $shared = true }) }" ` +
+				`type="button">Share</button>`,
+			Actual: components.ShareButton(components.ShareData{Title: "Post", URL: "/p/1"}),
+		},
+	})
+}
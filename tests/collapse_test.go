@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestCollapseWhitespace(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<p>Hello <b>bold</b> world</p>`,
+			Actual: CollapseWhitespace(P(
+				Text("\n  Hello "),
+				B(Text("bold")),
+				Text("\n\t world \n"),
+			)),
+		},
+		{
+			Expected: `<div><p>one</p><p>two</p></div>`,
+			Actual: CollapseWhitespace(DIV(
+				Text("\n  "),
+				P(Text("one")),
+				Text("\n  "),
+				P(Text("two")),
+				Text("\n"),
+			)),
+		},
+		{
+			Expected: `<pre>  keep
+	this  </pre>`,
+			Actual: CollapseWhitespace(PRE(Text("  keep\n\tthis  "))),
+		},
+	})
+}
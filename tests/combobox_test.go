@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestCombobox(t *testing.T) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	cb := components.Combobox("city", []string{"Berlin", "Boston"})
+	assert.NoError(t, cb.Render(buf))
+	html := buf.String()
+
+	assert.Contains(t, html, `role="combobox"`)
+	assert.Contains(t, html, `aria-controls="city-listbox"`)
+	assert.Contains(t, html, `aria-autocomplete="list"`)
+	assert.Contains(t, html, `data-attr:aria-expanded="$cityOpen"`)
+	assert.Contains(t, html, `data-attr:aria-activedescendant="'city-opt-' + $cityActive"`)
+	assert.Contains(t, html, `data-bind:city`)
+	assert.Contains(t, html, `Math.min($cityActive + 1, 1)`)
+	assert.Contains(t, html, `<ul data-show="$cityOpen" id="city-listbox" role="listbox">`)
+	assert.Contains(t, html, `<li data-attr:aria-selected="$cityActive === 0" `+
+		`data-on:click="$city = 'Berlin'; $cityOpen = false" id="city-opt-0" role="option">Berlin</li>`)
+	assert.Contains(t, html, `id="city-opt-1" role="option">Boston</li>`)
+
+	buf.Reset()
+	assert.NoError(t, components.Combobox("city", nil,
+		components.WithComboboxFetchURL("/cities")).Render(buf))
+	assert.Contains(t, buf.String(), `data-on:input__debounce.300ms="@get('/cities')"`)
+
+	// Suggestions are data: quotes must neither break the JS expression nor
+	// the attribute.
+	buf.Reset()
+	assert.NoError(t, components.Combobox("name",
+		[]string{`O'Brien`, `x" onmouseover="alert(1)`}).Render(buf))
+	html = buf.String()
+	assert.Contains(t, html, `data-on:click="$name = 'O\&#39;Brien'; $nameOpen = false"`)
+	assert.NotContains(t, html, `onmouseover="alert`)
+	assert.Contains(t, html, `$name = 'x&#34; onmouseover=&#34;alert(1)'`)
+}
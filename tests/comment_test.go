@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestComment(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div><!-- build 4f3a2c --><p>content</p></div>`,
+			Actual:   DIV(Comment(" build 4f3a2c "), P(Text("content"))),
+		},
+		// A "-->" in the text cannot terminate the comment early.
+		{
+			Expected: `<!--a --&gt; b-->`,
+			Actual:   Comment("a --> b"),
+		},
+	})
+}
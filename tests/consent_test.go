@@ -0,0 +1,24 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestConsentBanner(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div aria-label="Cookie consent" class="gostar-consent" ` +
+				`data-show="$consent === ''" ` +
+				`data-signals:consent="localStorage.getItem('consent') || ''" ` +
+				`id="gostar-consent" role="dialog">` +
+				`<p>We use cookies.</p>` +
+				`<button data-on:click="$consent = 'accepted'; localStorage.setItem('consent', 'accepted'); @post('/consent')" type="button">Accept</button>` +
+				`<button data-on:click="$consent = 'rejected'; localStorage.setItem('consent', 'rejected'); @post('/consent')" type="button">Reject</button>` +
+				`</div>`,
+			Actual: components.ConsentBanner("/consent", P().Text("We use cookies.")),
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/css"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriticalCSS(t *testing.T) {
+	page := DIV().ID("app").CLASS("container").Children(
+		BUTTON().CLASS("btn", "btn-primary").Text("Go"),
+		SPAN().Text("plain"),
+	)
+
+	usage := css.Collect(page)
+	assert.True(t, usage.HasTag("button"))
+	assert.True(t, usage.HasClass("btn-primary"))
+	assert.True(t, usage.HasID("app"))
+	assert.False(t, usage.HasClass("unused"))
+
+	run(t, []result{
+		{
+			Expected: `<style>.btn{color:red}</style>`,
+			Actual: css.InlineCritical(page, func(u *css.Usage) string {
+				if u.HasClass("btn") {
+					return ".btn{color:red}"
+				}
+				return ""
+			}),
+		},
+		{
+			Expected: ``,
+			Actual: css.InlineCritical(SPAN(), func(u *css.Usage) string {
+				if u.HasClass("btn") {
+					return ".btn{color:red}"
+				}
+				return ""
+			}),
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument(t *testing.T) {
+	doc := Document().
+		Lang("en").
+		Title("Pricing & plans").
+		Head(LINK().REL("stylesheet").HREF("/app.css")).
+		Body(H1(Text("Pricing")))
+
+	var sb strings.Builder
+	assert.NoError(t, doc.Render(&sb))
+	assert.Equal(t,
+		`<!DOCTYPE html><html lang="en"><head>`+
+			`<meta charset="utf-8" >`+
+			`<meta content="width=device-width, initial-scale=1" name="viewport" >`+
+			`<title>Pricing &amp; plans</title>`+
+			`<link href="/app.css" rel="stylesheet" >`+
+			`</head><body><h1>Pricing</h1></body></html>`,
+		sb.String())
+}
+
+func TestDocumentOverrides(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, Document().Viewport("").Render(&sb))
+	assert.Equal(t,
+		`<!DOCTYPE html><html><head><meta charset="utf-8" ></head><body></body></html>`,
+		sb.String())
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/dev"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDump(t *testing.T) {
+	root := DIV(
+		H1(Text("Title")),
+		UL(LI(Text("one")), LI(Text("two"))),
+	).ID("page").CLASS("wrap")
+
+	var sb strings.Builder
+	assert.NoError(t, dev.Dump(&sb, root))
+	out := sb.String()
+
+	assert.Contains(t, out, "<div id class>")
+	assert.Contains(t, out, "  <h1>")
+	assert.Contains(t, out, "    \"Title\"")
+	assert.Contains(t, out, "  <ul>")
+	assert.Contains(t, out, "    <li>")
+	assert.Contains(t, out, "5 elements, 3 text nodes, 2 attributes")
+
+	sb.Reset()
+	assert.NoError(t, dev.Dump(&sb, root, dev.WithMaxDepth(0)))
+	out = sb.String()
+	assert.Contains(t, out, "<div id class>")
+	assert.Contains(t, out, "  …")
+	assert.NotContains(t, out, "<h1>")
+}
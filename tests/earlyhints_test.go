@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/head"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkHeaders(t *testing.T) {
+	page := HEAD(
+		LINK().REL("preload").HREF("/app.css").Attr("as", "style"),
+		LINK().REL("preconnect").HREF("https://cdn.example"),
+		LINK().REL("modulepreload").HREF("/app.js").Attr("crossorigin", ""),
+		LINK().REL("stylesheet").HREF("/app.css"),
+	)
+
+	assert.Equal(t, []string{
+		"</app.css>; rel=preload; as=style",
+		"<https://cdn.example>; rel=preconnect",
+		"</app.js>; rel=modulepreload; crossorigin",
+	}, head.LinkHeaders(page))
+
+	assert.Empty(t, head.LinkHeaders(DIV()))
+}
+
+func TestEarlyHints(t *testing.T) {
+	page := HEAD(LINK().REL("preload").HREF("/app.css").Attr("as", "style"))
+
+	rec := httptest.NewRecorder()
+	head.EarlyHints(rec, page)
+	assert.Equal(t, 103, rec.Code)
+	assert.Equal(t, []string{"</app.css>; rel=preload; as=style"}, rec.Header()["Link"])
+
+	rec = httptest.NewRecorder()
+	head.EarlyHints(rec, DIV())
+	assert.Equal(t, 200, rec.Code)
+	assert.Empty(t, rec.Header()["Link"])
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/email"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestEmailLayout(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<table border="0" cellpadding="0" cellspacing="0" class="email-section" ` +
+				`role="presentation" width="100%"><tr><td><p>hello</p></td></tr></table>`,
+			Actual: email.Section(P(Text("hello"))),
+		},
+		{
+			Expected: `<table border="0" cellpadding="0" cellspacing="0" class="email-columns" ` +
+				`role="presentation" width="100%"><tr>` +
+				`<td valign="top" width="50%">left</td>` +
+				`<td valign="top" width="50%">right</td>` +
+				`</tr></table>`,
+			Actual: email.Columns(
+				email.Column("50%", Text("left")),
+				email.Column("50%", Text("right")),
+			),
+		},
+		{
+			Expected: `<table border="0" cellpadding="0" cellspacing="0" class="email-spacer" ` +
+				`role="presentation" width="100%">` +
+				`<tr><td height="20" style="font-size:0;line-height:20px">&nbsp;</td></tr></table>`,
+			Actual: email.Spacer(20),
+		},
+	})
+}
+
+func TestEmailButton(t *testing.T) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	assert.NoError(t, email.Button("https://ex.io/verify", "Verify email").Render(buf))
+	html := buf.String()
+	assert.Contains(t, html, `role="presentation"`)
+	assert.Contains(t, html, `<td align="center" class="email-button">`)
+	assert.Contains(t, html,
+		`<a href="https://ex.io/verify" style="display:inline-block;text-decoration:none" target="_blank">Verify email</a>`)
+}
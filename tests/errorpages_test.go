@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/respond"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotFoundPage(t *testing.T) {
+	var sb strings.Builder
+	page := components.NotFoundPage(
+		components.WithErrorBrand(SPAN(Text("Acme")).CLASS("logo")),
+		components.WithErrorHome("/dashboard", "Back to the dashboard"),
+	)
+	assert.NoError(t, page.Render(&sb))
+	out := sb.String()
+	assert.Contains(t, out, `<main class="gostar-error-page">`)
+	assert.Contains(t, out, `<span class="logo">Acme</span>`)
+	assert.Contains(t, out, "<h1>Page not found</h1>")
+	assert.Contains(t, out, `<a href="/dashboard">Back to the dashboard</a>`)
+}
+
+func TestServerErrorPageDetails(t *testing.T) {
+	render := func(dev bool) string {
+		var sb strings.Builder
+		page := components.ServerErrorPage(
+			components.WithErrorDetails("goroutine 1 [running]", dev),
+		)
+		assert.NoError(t, page.Render(&sb))
+		return sb.String()
+	}
+
+	assert.Contains(t, render(true), "<pre>goroutine 1 [running]</pre>")
+	assert.NotContains(t, render(false), "goroutine", "details never leak outside dev")
+
+	// Details quote request input, so markup in them must not render.
+	var sb strings.Builder
+	page := components.ServerErrorPage(
+		components.WithErrorDetails(`parse "/<script>alert(1)</script>": bad path`, true),
+	)
+	assert.NoError(t, page.Render(&sb))
+	assert.NotContains(t, sb.String(), "<script>")
+	assert.Contains(t, sb.String(), "&lt;script&gt;")
+}
+
+func TestMaintenancePage(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, components.MaintenancePage("30 minutes").Render(&sb))
+	assert.Contains(t, sb.String(), "<h1>Down for maintenance</h1>")
+	assert.Contains(t, sb.String(), "back in 30 minutes")
+	assert.NotContains(t, sb.String(), "<a ", "no escape hatch while everything is down")
+}
+
+func TestRespondError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	assert.NoError(t, respond.Error(rec, http.StatusNotFound, components.NotFoundPage()))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<h1>Page not found</h1>")
+}
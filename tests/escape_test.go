@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestEscapeF(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<a href="/users/42/orders/a%20b%2Fc">order</a>`,
+			Actual:   A().HREF(EscapeURLF("/users/%d/orders/%s", 42, "a b/c")).Text("order"),
+		},
+		{
+			Expected: `<div title="&lt;script&gt;"></div>`,
+			Actual:   DIV().TITLE(EscapeF("%s", "<script>")),
+		},
+	})
+}
+
+func TestEscapedTextNodes(t *testing.T) {
+	run(t, []result{
+		// Escaped and EscapedF are standalone nodes, usable in any child
+		// position without a per-element TEXT method.
+		{
+			Expected: `<p>Hi &lt;b&gt;there&lt;/b&gt;</p>`,
+			Actual:   P(Escaped("Hi <b>there</b>")),
+		},
+		{
+			Expected: `<li>2 &lt; 3</li>`,
+			Actual:   LI(EscapedF("%d < %d", 2, 3)),
+		},
+		// Text stays verbatim for trusted literals.
+		{
+			Expected: `<p>a < b</p>`,
+			Actual:   P(Text("a < b")),
+		},
+	})
+}
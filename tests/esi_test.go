@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/esi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestESIFragment(t *testing.T) {
+	page := DIV(
+		H1(Text("Shop")),
+		esi.Fragment("/fragments/cart", SPAN(Text("3 items"))),
+	)
+
+	var inline strings.Builder
+	assert.NoError(t, page.Render(&inline))
+	assert.Equal(t, "<div><h1>Shop</h1><span>3 items</span></div>", inline.String())
+
+	var edge strings.Builder
+	assert.NoError(t, esi.ForEdge(page).Render(&edge))
+	assert.Equal(t, `<div><h1>Shop</h1><esi:include src="/fragments/cart"/></div>`, edge.String())
+}
+
+func TestESIHandler(t *testing.T) {
+	h := esi.Handler(map[string]func(r *http.Request) ElementRenderer{
+		"/fragments/cart": func(r *http.Request) ElementRenderer {
+			return SPAN(Text("3 items"))
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/fragments/cart", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "<span>3 items</span>", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/fragments/missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestEdgeRequested(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	assert.False(t, esi.EdgeRequested(r))
+	r.Header.Set("Surrogate-Capability", `varnish="ESI/1.0"`)
+	assert.True(t, esi.EdgeRequested(r))
+
+	rec := httptest.NewRecorder()
+	esi.Control(rec)
+	assert.Equal(t, `content="ESI/1.0"`, rec.Header().Get("Surrogate-Control"))
+}
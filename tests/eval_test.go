@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	signals := map[string]any{
+		"count": 3,
+		"name":  "ada",
+		"open":  true,
+	}
+
+	cases := map[string]any{
+		"$count":                       float64(3),
+		"$count + 1":                   float64(4),
+		"$count * 2 - 1":               float64(5),
+		"$count >= 3":                  true,
+		"$name == 'ada'":               true,
+		"!$open":                       false,
+		"$open && $count > 1":          true,
+		"($count < 2) || $name != 'x'": true,
+		"'Hello, ' + $name":            "Hello, ada",
+		"null == null":                 true,
+	}
+	for expression, want := range cases {
+		got, err := expr.Eval(expression, signals)
+		assert.NoError(t, err, expression)
+		assert.Equal(t, want, got, expression)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	_, err := expr.Eval("$missing > 0", map[string]any{})
+	assert.ErrorContains(t, err, "undeclared signal $missing")
+
+	_, err = expr.Eval("@get('/x')", nil)
+	assert.ErrorContains(t, err, "unsupported syntax")
+
+	_, err = expr.Eval("'a' < 'b'", nil)
+	assert.ErrorContains(t, err, "needs numbers")
+}
+
+func TestEvalUncomparableSignals(t *testing.T) {
+	// Map- and slice-valued signals must compare without panicking.
+	signals := map[string]any{
+		"filters": map[string]any{"tag": "go"},
+		"same":    map[string]any{"tag": "go"},
+		"items":   []any{1.0, 2.0},
+	}
+
+	got, err := expr.Eval("$filters == $same", signals)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = expr.Eval("$items != $filters", signals)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+}
+
+func TestSignals(t *testing.T) {
+	refs := expr.Signals("$open && ($count > $count) || @post('/x?y=' + $userName)")
+	assert.Equal(t, []string{"open", "count", "userName"}, refs)
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedBucketer int
+
+func (f fixedBucketer) Bucket(string, int) int { return int(f) }
+
+func TestExperiment(t *testing.T) {
+	exp := func(b flags.Bucketer, exposure func(string, int)) string {
+		var sb strings.Builder
+		root := flags.WithBucketer(b, exposure,
+			flags.Experiment("checkout", SPAN(Text("control")), SPAN(Text("variant"))))
+		assert.NoError(t, root.Render(&sb))
+		return sb.String()
+	}
+
+	var exposed []string
+	log := func(name string, variant int) {
+		exposed = append(exposed, name)
+	}
+
+	assert.Equal(t, "<span>control</span>", exp(fixedBucketer(0), log))
+	assert.Equal(t, "<span>variant</span>", exp(fixedBucketer(1), log))
+	assert.Equal(t, "<span>control</span>", exp(fixedBucketer(99), log), "out-of-range falls back to control")
+	assert.Equal(t, []string{"checkout", "checkout", "checkout"}, exposed)
+
+	// Outside a bucketer: control, no exposure.
+	var sb strings.Builder
+	exposed = nil
+	assert.NoError(t, flags.Experiment("checkout",
+		SPAN(Text("control")), SPAN(Text("variant"))).Render(&sb))
+	assert.Equal(t, "<span>control</span>", sb.String())
+	assert.Empty(t, exposed)
+}
+
+func TestHashBucketer(t *testing.T) {
+	b := flags.HashBucketer{Key: "user-42"}
+	first := b.Bucket("checkout", 2)
+	assert.Equal(t, first, b.Bucket("checkout", 2), "assignment is stable per subject")
+	assert.GreaterOrEqual(t, first, 0)
+	assert.Less(t, first, 2)
+
+	// Different subjects land in both buckets eventually.
+	seen := map[int]bool{}
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		seen[flags.HashBucketer{Key: key}.Bucket("checkout", 2)] = true
+	}
+	assert.Len(t, seen, 2)
+}
@@ -0,0 +1,23 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimisticExpression(t *testing.T) {
+	got := expr.Optimistic("likes", "$likes++", "@post('/like')")
+	assert.Equal(t,
+		"$likesPrev = $likes; $likes++; @post('/like').catch(() => { $likes = $likesPrev; $likesError = true })",
+		got)
+
+	run(t, []result{
+		{
+			Expected: `<button data-on:click="` + got + `">Like</button>`,
+			Actual:   BUTTON().DATASTAR_ON("click", got).Text("Like"),
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"encoding/base64"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/head"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFavicon(t *testing.T) {
+	icon := SVG_SVG(SVG_CIRCLE())
+
+	href, err := head.FaviconDataURL(icon)
+	assert.NoError(t, err)
+	svg := `<svg><circle></circle></svg>`
+	assert.Equal(t, "data:image/svg+xml;base64,"+base64.StdEncoding.EncodeToString([]byte(svg)), href)
+
+	run(t, []result{
+		{
+			Expected: `<link href="` + href + `" rel="icon" type="image/svg+xml" >`,
+			Actual:   head.Favicon(SVG_SVG(SVG_CIRCLE())),
+		},
+	})
+
+	darkHref, err := head.FaviconDataURL(SVG_SVG(SVG_RECT()))
+	assert.NoError(t, err)
+	run(t, []result{
+		{
+			Expected: `<link href="` + href + `" media="(prefers-color-scheme: light)" rel="icon" type="image/svg+xml" >` +
+				`<link href="` + darkHref + `" media="(prefers-color-scheme: dark)" rel="icon" type="image/svg+xml" >`,
+			Actual: head.FaviconWithDarkMode(SVG_SVG(SVG_CIRCLE()), SVG_SVG(SVG_RECT())),
+		},
+	})
+}
@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestFetchButton(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<button data-attr:disabled="$saving" data-indicator="saving" ` +
+				`data-on:click="@post('/save')" type="button">Save</button>`,
+			Actual: components.FetchButton("saving", "@post('/save')", Text("Save")),
+		},
+	})
+}
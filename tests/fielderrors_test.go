@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/forms"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldErrors(t *testing.T) {
+	fe := forms.FieldErrors{}
+	assert.False(t, fe.Any())
+	fe.Add("email", "is required")
+	fe.Add("email", "must contain @")
+	assert.True(t, fe.Any())
+	assert.True(t, fe.Has("email"))
+	assert.False(t, fe.Has("name"))
+
+	form := FORM(
+		INPUT().TYPE(InputType_text).NAME("email"),
+		forms.ErrorMessages("email", fe),
+		INPUT().TYPE(InputType_text).NAME("name"),
+		forms.ErrorMessages("name", fe),
+	)
+	forms.WireErrors(form, fe)
+
+	run(t, []result{
+		{
+			Expected: `<form>` +
+				`<input aria-describedby="email-errors" aria-invalid="true" ` +
+				`data-custom-validity="$emailError" name="email" type="text" >` +
+				`<ul class="gostar-field-errors" id="email-errors" role="alert">` +
+				`<li>is required</li><li>must contain @</li></ul>` +
+				`<input name="name" type="text" >` +
+				`</form>`,
+			Actual: form,
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+type upperFilter struct {
+	dst    io.Writer
+	closed bool
+}
+
+func (u *upperFilter) Write(p []byte) (int, error) {
+	if _, err := u.dst.Write([]byte(strings.ToUpper(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (u *upperFilter) Close() error {
+	u.closed = true
+	return nil
+}
+
+func TestRenderFiltered(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, RenderFiltered(&sb, P(Text("hi"))))
+	assert.Equal(t, `<p>hi</p>`, sb.String())
+
+	sb.Reset()
+	var uf *upperFilter
+	filter := OutputFilterFunc(func(w io.Writer) io.WriteCloser {
+		uf = &upperFilter{dst: w}
+		return uf
+	})
+	assert.NoError(t, RenderFiltered(&sb, P(Text("hi")), filter))
+	assert.Equal(t, `<P>HI</P>`, sb.String())
+	assert.True(t, uf.closed)
+}
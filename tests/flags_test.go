@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlag(t *testing.T) {
+	render := func(p flags.Provider) string {
+		var sb strings.Builder
+		root := flags.WithFlags(p, DIV(
+			flags.Flag("new-checkout", SPAN(Text("new")), SPAN(Text("old"))),
+			flags.Flag("beta-banner", P(Text("beta")), nil),
+		))
+		assert.NoError(t, root.Render(&sb))
+		return sb.String()
+	}
+
+	on := render(flags.Map{"new-checkout": true})
+	assert.Contains(t, on, "<span>new</span>")
+	assert.NotContains(t, on, "beta")
+
+	off := render(flags.Map{})
+	assert.Contains(t, off, "<span>old</span>")
+
+	// Without a provider flags are off.
+	var sb strings.Builder
+	assert.NoError(t, flags.Flag("x", Text("on"), Text("off")).Render(&sb))
+	assert.Equal(t, "off", sb.String())
+}
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("FLAG_NEW_CHECKOUT", "1")
+	t.Setenv("FLAG_DARK_MODE", "false")
+
+	env := flags.Env{Prefix: "FLAG_"}
+	assert.True(t, env.Enabled("new-checkout"))
+	assert.False(t, env.Enabled("dark.mode"))
+	assert.False(t, env.Enabled("missing"))
+}
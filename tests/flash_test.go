@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestFlashRoundTrip(t *testing.T) {
+	store := &components.CookieFlashStore{}
+
+	// The mutating request queues a flash.
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/posts", nil)
+	assert.NoError(t, components.Flash(store, w, r, components.ToastSuccess, "Post created"))
+	cookies := w.Result().Cookies()
+	assert.Len(t, cookies, 1)
+
+	// The redirected render consumes it.
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/posts", nil)
+	r.AddCookie(cookies[0])
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	assert.NoError(t, components.FlashMessages(store, w, r).Render(buf))
+	html := buf.String()
+	assert.Contains(t, html, `id="gostar-toasts"`)
+	assert.Contains(t, html, "Post created")
+	assert.Contains(t, html, "gostar-toast-success")
+
+	// Consuming clears the cookie for the next request.
+	var cleared *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if strings.HasPrefix(c.Name, "gostar_flash") {
+			cleared = c
+		}
+	}
+	assert.NotNil(t, cleared)
+	assert.Equal(t, -1, cleared.MaxAge)
+
+	// A clean request renders nothing.
+	buf.Reset()
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/posts", nil)
+	assert.NoError(t, components.FlashMessages(store, w, r).Render(buf))
+	assert.Empty(t, buf.String())
+}
+
+func TestFlashLevelFromCookieIsValidated(t *testing.T) {
+	store := &components.CookieFlashStore{}
+
+	// The cookie is client-writable: a forged level must not reach the
+	// class attribute verbatim.
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/posts", nil)
+	assert.NoError(t, components.Flash(store, w, r, `info"><script>alert(1)</script>`, "hi"))
+	cookie := w.Result().Cookies()[0]
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/posts", nil)
+	r.AddCookie(cookie)
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	assert.NoError(t, components.FlashMessages(store, w, r).Render(buf))
+	assert.NotContains(t, buf.String(), "<script>")
+	assert.Contains(t, buf.String(), "gostar-toast-info")
+}
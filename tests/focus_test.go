@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/dev"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFocusHelpers(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div tabindex="-1"></div>`,
+			Actual:   DIV().TABINDEX(TabIndexSkip),
+		},
+		{
+			Expected: `<span tabindex="0"></span>`,
+			Actual:   SPAN().TABINDEX(TabIndexFocusable),
+		},
+	})
+
+	dev.SetEnabled(true)
+	defer dev.SetEnabled(false)
+
+	assert.Empty(t, dev.CheckAutofocus(FORM(INPUT().AUTOFOCUS(), INPUT())))
+
+	warnings := dev.CheckAutofocus(FORM(
+		INPUT().AUTOFOCUS(),
+		TEXTAREA().AUTOFOCUS(),
+	))
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "<textarea> is the 2nd")
+}
@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeze(t *testing.T) {
+	nav := NAV(
+		A().HREF("/").Text("Home"),
+		A().HREF("/about").Text("About"),
+	)
+	frozen, err := Freeze(nav)
+	assert.NoError(t, err)
+
+	run(t, []result{
+		{
+			Expected: `<nav><a href="/">Home</a><a href="/about">About</a></nav>`,
+			Actual:   frozen,
+		},
+	})
+}
+
+func TestFreezeConcurrentRender(t *testing.T) {
+	frozen := MustFreeze(FOOTER(P().Text("© gostar")))
+	expected := `<footer><p>© gostar</p></footer>`
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				sb := &strings.Builder{}
+				assert.NoError(t, frozen.Render(sb))
+				assert.Equal(t, expected, sb.String())
+			}
+		}()
+	}
+	wg.Wait()
+}
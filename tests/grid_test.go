@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestDataGrid(t *testing.T) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	grid := components.DataGrid("users", "/users/grid",
+		[]components.GridColumn{
+			{Key: "name", Label: "Name", Sortable: true},
+			{Label: "Actions"},
+		},
+		[][]ElementRenderer{
+			{Text("Ada"), Text("edit")},
+		},
+		components.WithGridPager(),
+	)
+	assert.NoError(t, grid.Render(buf))
+	html := buf.String()
+
+	assert.Contains(t, html, `data-signals:usersSort="''"`)
+	assert.Contains(t, html, `data-signals:usersDir="'asc'"`)
+	assert.Contains(t, html, `data-signals:usersPage="0"`)
+	assert.Contains(t, html,
+		`data-on:click="if ($usersSort === 'name') { $usersDir = $usersDir === 'asc' ? 'desc' : 'asc' } `+
+			`else { $usersSort = 'name'; $usersDir = 'asc' }; $usersPage = 0; @get('/users/grid')"`)
+	assert.Contains(t, html,
+		`data-attr:aria-sort="$usersSort === 'name' ? ($usersDir === 'asc' ? 'ascending' : 'descending') : 'none'"`)
+	assert.Contains(t, html, `<th>Actions</th>`)
+	assert.Contains(t, html, `<tbody id="gostar-grid-rows"><tr><td>Ada</td><td>edit</td></tr></tbody>`)
+	assert.Contains(t, html, `data-on:click="$usersPage = Math.max($usersPage - 1, 0); @get('/users/grid')"`)
+	assert.Contains(t, html, `data-on:click="$usersPage = $usersPage + 1; @get('/users/grid')"`)
+}
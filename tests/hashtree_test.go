@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashTree(t *testing.T) {
+	build := func(label string) ElementRenderer {
+		return DIV(P(Text(label))).ID("card").CLASS("a", "b")
+	}
+
+	h1, err := HashTree(build("hello"))
+	assert.NoError(t, err)
+	h2, err := HashTree(build("hello"))
+	assert.NoError(t, err)
+	assert.Equal(t, h1, h2, "equal trees must hash equal")
+
+	changedText, err := HashTree(build("world"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, h1, changedText)
+
+	changedAttr, err := HashTree(DIV(P(Text("hello"))).ID("other").CLASS("a", "b"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, h1, changedAttr)
+
+	changedTag, err := HashTree(SPAN(P(Text("hello"))).ID("card").CLASS("a", "b"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, h1, changedTag)
+
+	// Grouping is transparent, matching how Grouper renders.
+	flat, err := HashTree(Group(P(Text("a")), P(Text("b"))))
+	assert.NoError(t, err)
+	nested, err := HashTree(Group(Group(P(Text("a"))), P(Text("b"))))
+	assert.NoError(t, err)
+	assert.Equal(t, flat, nested)
+
+	_, err = HashTree(&failingRenderer{})
+	assert.Error(t, err)
+}
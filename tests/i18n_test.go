@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/i18n"
+	"golang.org/x/text/language"
+)
+
+func TestLocalizedFormatting(t *testing.T) {
+	launched := time.Date(2024, time.March, 7, 15, 30, 0, 0, time.UTC)
+
+	run(t, []result{
+		{
+			Expected: `<p>Mar 7, 2024 — 1,234,567.89</p>`,
+			Actual: i18n.Localize(language.English,
+				P(
+					i18n.FormattedTime(launched, i18n.TimeStyleDate),
+					Text(" — "),
+					i18n.FormattedNumber(1234567.891, i18n.WithScale(2)),
+				),
+			),
+		},
+		{
+			Expected: `<p>07.03.2024 — 1.234.567,89</p>`,
+			Actual: i18n.Localize(language.German,
+				P(
+					i18n.FormattedTime(launched, i18n.TimeStyleDate),
+					Text(" — "),
+					i18n.FormattedNumber(1234567.891, i18n.WithScale(2)),
+				),
+			),
+		},
+		{
+			Expected: `<span>15:30</span>`,
+			Actual: i18n.Localize(language.Japanese,
+				SPAN(i18n.FormattedTime(launched, i18n.TimeStyleTime)),
+			),
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/icons"
+)
+
+func TestIconRegistry(t *testing.T) {
+	reg := icons.NewRegistry()
+	reg.Register("search", "0 0 24 24", SVG_CIRCLE().Attr("cx", "11").Attr("cy", "11").Attr("r", "8"))
+	reg.Register("x", "0 0 24 24", SVG_PATH().D("M18 6 6 18"))
+
+	run(t, []result{
+		{
+			Expected: `<svg aria-hidden="true" class="icon" viewBox="0 0 24 24">` +
+				`<use href="#gostar-icon-search"></use></svg>`,
+			Actual: reg.Icon("search", "icon"),
+		},
+		{
+			Expected: ``,
+			Actual:   reg.Icon("unknown"),
+		},
+		{
+			Expected: `<svg aria-hidden="true" height="0" style="display:none" width="0">` +
+				`<symbol id="gostar-icon-search" viewBox="0 0 24 24">` +
+				`<circle cx="11" cy="11" r="8"></circle></symbol></svg>`,
+			Actual: reg.Sprite(),
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestInfiniteScroll(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div data-indicator="fetching" data-on-intersect__once="@get('/feed?page=2')" id="infinite-scroll">` +
+				`<div data-show="$fetching"><p>Loading more…</p></div></div>`,
+			Actual: components.InfiniteScroll("/feed?page=2"),
+		},
+		{
+			Expected: `<div data-indicator="loadingFeed" data-on-intersect__once="@get('/feed?page=3')" id="feed-sentinel">` +
+				`<div data-show="$loadingFeed"><span class="spinner"></span></div></div>`,
+			Actual: components.InfiniteScroll("/feed?page=3",
+				components.WithScrollID("feed-sentinel"),
+				components.WithScrollIndicator("loadingFeed"),
+				components.WithScrollLoading(SPAN().CLASS("spinner")),
+			),
+		},
+	})
+}
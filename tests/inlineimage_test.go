@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+)
+
+func TestInlineImage(t *testing.T) {
+	pixel := []byte{0x47, 0x49, 0x46, 0x38}
+	run(t, []result{
+		{
+			Expected: `<img alt="spacer" src="data:image/gif;base64,` + base64.StdEncoding.EncodeToString(pixel) + `" >`,
+			Actual:   components.InlineImage("image/gif", pixel).ALT("spacer"),
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestLayoutSlots(t *testing.T) {
+	base := components.NewLayout(func() ElementRenderer {
+		return DIV().CLASS("page").Children(
+			ASIDE(components.Slot("sidebar", P().Text("no sidebar"))),
+			MAIN(components.Slot("content")),
+		)
+	})
+
+	run(t, []result{
+		{
+			Expected: `<div class="page"><aside><nav>links</nav></aside><main><h1>Hello</h1></main></div>`,
+			Actual: base.With(
+				components.Fill("content", H1().Text("Hello")),
+				components.Fill("sidebar", NAV().Text("links")),
+			),
+		},
+		{
+			Expected: `<div class="page"><aside><p>no sidebar</p></aside><main></main></div>`,
+			Actual:   base.With(),
+		},
+	})
+}
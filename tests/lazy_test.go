@@ -0,0 +1,24 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestLazyFragment(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div data-on-intersect__once="@get('/sections/comments')" id="comments"></div>`,
+			Actual:   components.LazyFragment("/sections/comments"),
+		},
+		{
+			Expected: `<div data-on-intersect__once="@get('/feed/related')" id="related-posts"><p>Loading…</p></div>`,
+			Actual: components.LazyFragment("/feed/related",
+				components.WithLazyID("related-posts"),
+				components.WithLazyPlaceholder(P().Text("Loading…")),
+			),
+		},
+	})
+}
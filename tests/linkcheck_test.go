@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/linkcheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkcheckInternal(t *testing.T) {
+	routes := map[string]bool{"/": true, "/about": true, "/posts": true}
+	known := func(path string) bool { return routes[path] }
+
+	tree := DIV(
+		A(Text("home")).HREF("/"),
+		A(Text("about")).HREF("/about?tab=team#people"),
+		A(Text("gone")).HREF("/missing"),
+		A(Text("frag")).HREF("#top"),
+		A(Text("mail")).HREF("mailto:hi@ex.io"),
+		A(Text("rel")).HREF("missing.html"),
+		IMG().SRC("/posts"),
+	)
+
+	broken := linkcheck.Check(tree, known)
+	assert.Equal(t, []linkcheck.Broken{
+		{URL: "/missing", Reason: "no matching route"},
+		{URL: "missing.html", Reason: "relative URL; use a rooted path"},
+	}, broken)
+
+	assert.Empty(t, linkcheck.Check(tree, nil)[1:], "nil predicate only flags relative URLs")
+}
+
+func TestLinkcheckExternal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	tree := DIV(
+		A(Text("good")).HREF(srv.URL+"/ok"),
+		A(Text("bad")).HREF(srv.URL+"/nope"),
+	)
+
+	assert.Empty(t, linkcheck.Check(tree, nil), "externals skipped without a client")
+
+	broken := linkcheck.Check(tree, nil, linkcheck.WithExternalClient(srv.Client()))
+	assert.Equal(t, []linkcheck.Broken{
+		{URL: srv.URL + "/nope", Reason: "status 404"},
+	}, broken)
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/forms"
+	"github.com/delaneyj/gostar/sse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveValidate(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<input data-custom-validity="$userEmailError" ` +
+				`data-on:input__debounce.300ms="@post('/signup/validate')" ` +
+				`name="user_email" type="email" >`,
+			Actual: forms.LiveValidate(
+				INPUT().TYPE(InputType_email).NAME("user_email"),
+				"/signup/validate"),
+		},
+	})
+}
+
+func TestPushFieldErrors(t *testing.T) {
+	rec := sse.NewRecorder()
+
+	fe := forms.FieldErrors{}
+	fe.Add("user_email", "must contain @")
+	assert.NoError(t, forms.PushFieldErrors(rec.Generator, fe, "user_email", "name"))
+
+	signals := rec.PatchedSignals()
+	assert.Len(t, signals, 1)
+	assert.Contains(t, signals[0], `"userEmailError":"must contain @"`)
+	assert.Contains(t, signals[0], `"nameError":""`)
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/markdown"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestMarkdownToElement(t *testing.T) {
+	cases := []struct {
+		Source   string
+		Expected string
+	}{
+		{
+			Source:   "# Hello\n\nSome *emphasis* and **strong** text.",
+			Expected: `<h1>Hello</h1><p>Some <em>emphasis</em> and <strong>strong</strong> text.</p>`,
+		},
+		{
+			Source:   "- one\n- two",
+			Expected: `<ul><li>one</li><li>two</li></ul>`,
+		},
+		{
+			Source:   "[docs](https://example.com \"Docs\")",
+			Expected: `<p><a href="https://example.com" title="Docs">docs</a></p>`,
+		},
+		{
+			Source:   "```go\nfmt.Println(\"hi\")\n```",
+			Expected: "<pre><code class=\"language-go\">fmt.Println(&#34;hi&#34;)\n</code></pre>",
+		},
+		{
+			Source:   "a <script>alert(1)</script> b",
+			Expected: `<p>a &lt;script&gt;alert(1)&lt;/script&gt; b</p>`,
+		},
+		// Attribute values are escaped, so titles cannot break out of the
+		// attribute.
+		{
+			Source:   `[click](/safe 'a" onmouseover="alert(1)')`,
+			Expected: `<p><a href="/safe" title="a&#34; onmouseover=&#34;alert(1)">click</a></p>`,
+		},
+		// Script-capable URL schemes are dropped.
+		{
+			Source:   `[click](<javascript:alert(1)>) ![x](data:text/html;base64,PHM+)`,
+			Expected: `<p><a href>click</a> <img alt="x" src ></p>`,
+		},
+	}
+
+	for _, c := range cases {
+		el, err := markdown.ToElement([]byte(c.Source))
+		assert.NoError(t, err)
+
+		buf := bytebufferpool.Get()
+		err = el.Render(buf)
+		assert.NoError(t, err)
+		assert.Equal(t, c.Expected, buf.String())
+		bytebufferpool.Put(buf)
+	}
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/memo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoCache(t *testing.T) {
+	c := memo.New(10 * time.Millisecond)
+
+	var version atomic.Int32
+	version.Store(1)
+	fragment := c.Renderer("sidebar", func() ElementRenderer {
+		return ASIDE(Text(fmt.Sprintf("v%d", version.Load())))
+	})
+
+	render := func() string {
+		var sb strings.Builder
+		assert.NoError(t, fragment.Render(&sb))
+		return sb.String()
+	}
+
+	// First render misses and fills the cache, the second hits.
+	assert.Equal(t, "<aside>v1</aside>", render())
+	assert.Equal(t, "<aside>v1</aside>", render())
+	hits, misses, refreshes := c.Stats()
+	assert.Equal(t, uint64(1), hits)
+	assert.Equal(t, uint64(1), misses)
+	assert.Equal(t, uint64(0), refreshes)
+
+	// After the TTL the stale fragment is served immediately while a
+	// background refresh picks up the new version.
+	version.Store(2)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, "<aside>v1</aside>", render(), "stale content served without waiting")
+	assert.Eventually(t, func() bool {
+		_, _, refreshes := c.Stats()
+		return refreshes == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, "<aside>v2</aside>", render())
+}
+
+func TestMemoInvalidate(t *testing.T) {
+	c := memo.New(time.Hour)
+
+	label := "before"
+	fragment := c.Renderer("badge", func() ElementRenderer {
+		return SPAN(Text(label))
+	})
+
+	var sb strings.Builder
+	assert.NoError(t, fragment.Render(&sb))
+	assert.Equal(t, "<span>before</span>", sb.String())
+
+	label = "after"
+	c.Invalidate("badge")
+	sb.Reset()
+	assert.NoError(t, fragment.Render(&sb))
+	assert.Equal(t, "<span>after</span>", sb.String())
+
+	_, misses, _ := c.Stats()
+	assert.Equal(t, uint64(2), misses)
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/normalize"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestNormalize(t *testing.T) {
+	// Different formatting, same document.
+	a, err := normalize.Normalize(`<div id="x" class="card">
+		<p>Hello <b>world</b></p>
+	</div>`)
+	assert.NoError(t, err)
+	b, err := normalize.Normalize(`<DIV CLASS="card" ID="x"><p>Hello <B>world</B></p></DIV>`)
+	assert.NoError(t, err)
+	assert.Equal(t, a, b)
+	assert.Equal(t, `<div class="card" id="x"><p>Hello <b>world</b></p></div>`, a)
+
+	// gostar output round-trips through its own conventions.
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	assert.NoError(t, DIV(P(Escaped("a&b"))).CLASS("c").Render(buf))
+	n, err := normalize.Normalize(buf.String())
+	assert.NoError(t, err)
+	assert.Equal(t, buf.String(), n)
+
+	// Void elements keep gostar's self-closing form.
+	v, err := normalize.Normalize(`<input type="text" name="q"/>`)
+	assert.NoError(t, err)
+	assert.Equal(t, `<input name="q" type="text" >`, v)
+}
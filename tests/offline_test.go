@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+)
+
+func TestConnectionStatus(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div data-on:offline__window="$online = false" data-on:online__window="$online = true" ` +
+				`data-signals:online="true" id="gostar-connection-status">` +
+				`<div class="gostar-offline-banner" data-show="!$online" role="status">` +
+				`You are offline — changes will sync when the connection returns.</div></div>`,
+			Actual: components.ConnectionStatus(),
+		},
+		{
+			Expected: `<div data-on:offline__window="$online = false" data-on:online__window="$online = true" ` +
+				`data-signals:online="true" id="gostar-connection-status">` +
+				`<div class="gostar-offline-banner" data-show="!$online" role="status">No connection</div></div>`,
+			Actual: components.ConnectionStatus(components.WithOfflineMessage("No connection")),
+		},
+	})
+}
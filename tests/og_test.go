@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/head"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOGImages(t *testing.T) {
+	var rasterized string
+	og := head.NewOGImages(head.RasterizerFunc(func(svg []byte, width, height int) ([]byte, error) {
+		rasterized = string(svg)
+		assert.Equal(t, 1200, width)
+		assert.Equal(t, 630, height)
+		return []byte("png-bytes"), nil
+	}))
+	og.Register("/og/home.png", func() ElementRenderer {
+		return SVG_SVG(SVG_TEXT(Text("gostar")))
+	})
+
+	run(t, []result{
+		{
+			Expected: `<meta content="/og/home.png" property="og:image" >` +
+				`<meta content="1200" property="og:image:width" >` +
+				`<meta content="630" property="og:image:height" >` +
+				`<meta content="image/png" property="og:image:type" >`,
+			Actual: og.Meta("/og/home.png"),
+		},
+		{
+			Expected: ``,
+			Actual:   og.Meta("/not-registered"),
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	og.ServeHTTP(rec, httptest.NewRequest("GET", "/og/home.png", nil))
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "png-bytes", rec.Body.String())
+	assert.Contains(t, rasterized, "<svg>")
+
+	rec = httptest.NewRecorder()
+	og.ServeHTTP(rec, httptest.NewRequest("GET", "/nope.png", nil))
+	assert.Equal(t, 404, rec.Code)
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagination(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, components.Pagination("/articles", 2, 3).Render(&sb))
+	out := sb.String()
+
+	assert.Contains(t, out, `<nav aria-label="Pagination">`)
+	assert.Contains(t, out, `<a href="/articles" rel="prev">Previous</a>`)
+	assert.Contains(t, out, `<a aria-current="page" href="/articles?page=2">2</a>`)
+	assert.Contains(t, out, `<a href="/articles?page=3" rel="next">Next</a>`)
+}
+
+func TestPaginationHead(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, components.PaginationHead("/articles", 2, 3).Render(&sb))
+	assert.Equal(t,
+		`<link href="/articles?page=2" rel="canonical" >`+
+			`<link href="/articles" rel="prev" >`+
+			`<link href="/articles?page=3" rel="next" >`,
+		sb.String())
+
+	// The first page has no prev link and a bare canonical.
+	sb.Reset()
+	assert.NoError(t, components.PaginationHead("/articles", 1, 3).Render(&sb))
+	assert.Equal(t,
+		`<link href="/articles" rel="canonical" >`+
+			`<link href="/articles?page=2" rel="next" >`,
+		sb.String())
+}
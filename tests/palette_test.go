@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+)
+
+func TestCommandPalette(t *testing.T) {
+	commands := []components.PaletteCommand{
+		{Label: "New Post", Action: "@get('/posts/new')"},
+		{Label: "Settings", Action: "@get('/settings')"},
+	}
+
+	run(t, []result{
+		{
+			Expected: `<dialog class="gostar-palette" data-attr:open="$paletteOpen" ` +
+				`data-on:keydown="if (evt.key === 'Escape') $paletteOpen = false" id="gostar-palette">` +
+				`<input aria-label="Command palette" data-bind:paletteQuery placeholder="Type a command…" type="search" >` +
+				`<ul id="gostar-palette-list" role="listbox">` +
+				`<li data-show="$paletteQuery === '' || 'new post'.toLowerCase().includes($paletteQuery.toLowerCase())" role="option">` +
+				`<button data-on:click="@get('/posts/new'); $paletteOpen = false" type="button">New Post</button></li>` +
+				`<li data-show="$paletteQuery === '' || 'settings'.toLowerCase().includes($paletteQuery.toLowerCase())" role="option">` +
+				`<button data-on:click="@get('/settings'); $paletteOpen = false" type="button">Settings</button></li>` +
+				`</ul></dialog>`,
+			Actual: components.CommandPalette(commands),
+		},
+		{
+			Expected: `<dialog class="gostar-palette" data-attr:open="$paletteOpen" ` +
+				`data-on:keydown="if (evt.key === 'Escape') $paletteOpen = false" id="gostar-palette">` +
+				`<input aria-label="Command palette" data-bind:paletteQuery ` +
+				`data-on:input__debounce.300ms="@get('/commands/search')" placeholder="Search…" type="search" >` +
+				`<ul id="gostar-palette-list" role="listbox">` +
+				`<li role="option"><button data-on:click="@get('/posts/new'); $paletteOpen = false" type="button">New Post</button></li>` +
+				`<li role="option"><button data-on:click="@get('/settings'); $paletteOpen = false" type="button">Settings</button></li>` +
+				`</ul></dialog>`,
+			Actual: components.CommandPalette(commands,
+				components.WithPaletteSearchURL("/commands/search"),
+				components.WithPalettePlaceholder("Search…"),
+			),
+		},
+		// A quote in a label must not break the data-show expression or
+		// the attribute.
+		{
+			Expected: `<dialog class="gostar-palette" data-attr:open="$paletteOpen" ` +
+				`data-on:keydown="if (evt.key === 'Escape') $paletteOpen = false" id="gostar-palette">` +
+				`<input aria-label="Command palette" data-bind:paletteQuery placeholder="Type a command…" type="search" >` +
+				`<ul id="gostar-palette-list" role="listbox">` +
+				`<li data-show="$paletteQuery === '' || 'bob\&#39;s &#34;stuff&#34;'.toLowerCase().includes($paletteQuery.toLowerCase())" role="option">` +
+				`<button data-on:click="@get('/b'); $paletteOpen = false" type="button">Bob's "stuff"</button></li>` +
+				`</ul></dialog>`,
+			Actual: components.CommandPalette([]components.PaletteCommand{
+				{Label: `Bob's "stuff"`, Action: "@get('/b')"},
+			}),
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionExpressions(t *testing.T) {
+	assert.Equal(t,
+		`navigator.geolocation.getCurrentPosition(`+
+			`(pos) => { $hereLat = pos.coords.latitude; $hereLng = pos.coords.longitude }, `+
+			`(err) => { $hereError = err.message })`,
+		expr.Geolocate("here"))
+
+	assert.Equal(t,
+		`Notification.requestPermission()`+
+			`.then((p) => { $notify = p })`+
+			`.catch((err) => { $notifyError = err.message })`,
+		expr.RequestNotifications("notify"))
+
+	assert.Equal(t,
+		`navigator.permissions.query({name: 'geolocation'}).then((s) => { `+
+			`$geo = s.state; if (s.state === 'granted') { @get('/nearby') } })`,
+		expr.PermissionGated("geolocation", "geo", "@get('/nearby')"))
+}
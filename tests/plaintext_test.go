@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/email"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaintext(t *testing.T) {
+	tree := DIV(
+		H1(Text("Welcome")),
+		P(Text("Read the "), A(Text("docs")).HREF("https://ex.io/docs"), Text(" first.")),
+		UL(LI(Text("one")), LI(Text("two"))),
+		OL(LI(Text("first")), LI(Text("second"))),
+		P(Text("line"), BR(), Text("break")),
+		SCRIPT(Text("ignored()")),
+		IMG().ALT("logo").SRC("/logo.png"),
+	)
+
+	assert.Equal(t,
+		"WELCOME\n\n"+
+			"Read the docs (https://ex.io/docs) first.\n\n"+
+			"- one\n"+
+			"- two\n\n"+
+			"1. first\n"+
+			"2. second\n\n"+
+			"line\nbreak\n"+
+			"logo",
+		email.Plaintext(tree))
+}
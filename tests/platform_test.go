@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestRecentPlatformFeatures(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<search><input type="search" ></search>`,
+			Actual:   SEARCH(INPUT().TYPE("search")),
+		},
+		{
+			Expected: `<dialog closedby="any" open></dialog>`,
+			Actual:   DIALOG().OPEN().CLOSEDBY(DialogClosedby_any),
+		},
+		{
+			Expected: `<script blocking="render" src="/app.js"></script>`,
+			Actual:   SCRIPT().SRC("/app.js").BLOCKING("render"),
+		},
+		{
+			Expected: `<link blocking="render" href="/app.css" rel="stylesheet" >`,
+			Actual:   LINK().REL("stylesheet").HREF("/app.css").BLOCKING("render"),
+		},
+		{
+			Expected: `<div inert></div>`,
+			Actual:   DIV().INERT(),
+		},
+		{
+			Expected: `<div anchor="menu-button"></div>`,
+			Actual:   DIV().ANCHOR("menu-button"),
+		},
+	})
+}
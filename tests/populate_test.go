@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"net/url"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/forms"
+)
+
+func TestPopulate(t *testing.T) {
+	form := FORM(
+		INPUT().TYPE(InputType_text).NAME("title"),
+		INPUT().TYPE(InputType_checkbox).NAME("draft"),
+		INPUT().TYPE(InputType_radio).NAME("visibility").VALUE("public"),
+		INPUT().TYPE(InputType_radio).NAME("visibility").VALUE("private").CHECKED(),
+		TEXTAREA().NAME("body"),
+		SELECT(
+			OPTION(Text("Go")).VALUE("go").SELECTED(),
+			OPTION(Text("Rust")).VALUE("rust"),
+		).NAME("lang"),
+		INPUT().TYPE(InputType_text).NAME("untouched").VALUE("keep"),
+	)
+
+	forms.Populate(form, url.Values{
+		"title":      {"Hello <world>"},
+		"draft":      {"on"},
+		"visibility": {"public"},
+		"body":       {"typed & saved"},
+		"lang":       {"rust"},
+	})
+
+	run(t, []result{
+		{
+			Expected: `<form>` +
+				`<input name="title" type="text" value="Hello &lt;world&gt;" >` +
+				`<input checked name="draft" type="checkbox" >` +
+				`<input checked name="visibility" type="radio" value="public" >` +
+				`<input name="visibility" type="radio" value="private" >` +
+				`<textarea name="body">typed &amp; saved</textarea>` +
+				`<select name="lang">` +
+				`<option value="go">Go</option>` +
+				`<option selected value="rust">Rust</option>` +
+				`</select>` +
+				`<input name="untouched" type="text" value="keep" >` +
+				`</form>`,
+			Actual: form,
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/head"
+)
+
+func TestPrintHelpers(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<link href="/print.css" media="print" rel="stylesheet" >`,
+			Actual:   head.PrintStylesheet("/print.css"),
+		},
+		{
+			Expected: `<style>@page{size:A4;margin:2cm}</style>`,
+			Actual:   head.PageSize("A4", "2cm"),
+		},
+		{
+			Expected: `<style>@media print{.print-break-before{break-before:page}` +
+				`.print-break-after{break-after:page}` +
+				`.print-avoid-break{break-inside:avoid}}</style>`,
+			Actual: head.PrintUtilities(),
+		},
+		{
+			Expected: `<section class="print-avoid-break"></section>`,
+			Actual:   SECTION().CLASS(head.ClassAvoidBreak),
+		},
+	})
+}
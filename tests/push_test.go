@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/push"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestPushComponents(t *testing.T) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	assert.NoError(t, push.RegisterServiceWorker("/sw.js").Render(buf))
+	assert.Equal(t,
+		`<script>if ('serviceWorker' in navigator) { navigator.serviceWorker.register('/sw.js') }</script>`,
+		buf.String())
+
+	buf.Reset()
+	assert.NoError(t, push.SubscribeButton("BKey123", "/push/subscribe").Render(buf))
+	html := buf.String()
+	assert.Contains(t, html, `applicationServerKey: 'BKey123'`)
+	assert.Contains(t, html, `fetch('/push/subscribe'`)
+	assert.Contains(t, html, `$pushSubscribed = true`)
+	assert.Contains(t, html, `$pushError = err.message`)
+	assert.Contains(t, html, `>Enable notifications</button>`)
+}
+
+func TestSubscriptionHandler(t *testing.T) {
+	var stored *push.Subscription
+	handler := push.SubscriptionHandler(func(r *http.Request, sub *push.Subscription) error {
+		stored = sub
+		return nil
+	})
+
+	body := `{"endpoint":"https://push.example/abc","keys":{"p256dh":"pk","auth":"ak"}}`
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/push/subscribe", strings.NewReader(body)))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "https://push.example/abc", stored.Endpoint)
+	assert.Equal(t, "pk", stored.Keys.P256DH)
+	assert.Equal(t, "ak", stored.Keys.Auth)
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/push/subscribe", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/push/subscribe", strings.NewReader(`{}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
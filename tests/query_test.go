@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/routes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLWith(t *testing.T) {
+	assert.Equal(t, "/posts?page=2&sort=name",
+		routes.URLWith("/posts", routes.Q("sort", "name"), routes.Q("page", 2)))
+	assert.Equal(t, "/posts", routes.URLWith("/posts"))
+	assert.Equal(t, "/search?q=a+%26+b",
+		routes.URLWith("/search", routes.Q("q", "a & b")))
+	assert.Equal(t, "/posts?page=2&tag=go#top",
+		routes.URLWith("/posts?tag=go#top", routes.Q("page", 2)))
+	assert.Equal(t, "/f?tag=a&tag=b",
+		routes.URLWith("/f", routes.Q("tag", "a"), routes.Q("tag", "b")))
+}
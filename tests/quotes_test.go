@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/i18n"
+	"golang.org/x/text/language"
+)
+
+func TestLangAwareQuotes(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<q cite="https://example.com/speech">brevity</q>`,
+			Actual:   i18n.Quote("https://example.com/speech", Text("brevity")),
+		},
+		{
+			Expected: `<q>nope</q>`,
+			Actual:   i18n.Quote("javascript:alert(1)", Text("nope")),
+		},
+		{
+			Expected: `<blockquote cite="/posts/42"><p>quoted</p></blockquote>`,
+			Actual:   i18n.Blockquote("/posts/42", P().Text("quoted")),
+		},
+	})
+}
+
+func TestFormattedList(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<p>red, green, and blue</p>`,
+			Actual: i18n.Localize(language.English,
+				P(i18n.FormattedList("red", "green", "blue"))),
+		},
+		{
+			Expected: `<p>rot, grün und blau</p>`,
+			Actual: i18n.Localize(language.German,
+				P(i18n.FormattedList("rot", "grün", "blau"))),
+		},
+		{
+			Expected: `<p>tea and cake</p>`,
+			Actual: i18n.Localize(language.English,
+				P(i18n.FormattedList("tea", "cake"))),
+		},
+	})
+}
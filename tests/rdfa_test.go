@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestMicrodataAndRDFa(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div itemscope itemtype="https://schema.org/Person"><span itemprop="name">Jane</span></div>`,
+			Actual: DIV(
+				SPAN().ITEMPROP("name").Text("Jane"),
+			).ITEMSCOPE().ITEMTYPE("https://schema.org/Person"),
+		},
+		{
+			Expected: `<div typeof="schema:Person" vocab="https://schema.org/"><span property="name">Jane</span></div>`,
+			Actual: DIV(
+				SPAN().PROPERTY("name").Text("Jane"),
+			).VOCAB("https://schema.org/").TYPEOF("schema:Person"),
+		},
+		{
+			Expected: `<a href="/jane" property="url" resource="#jane">Jane</a>`,
+			Actual:   A().HREF("/jane").PROPERTY("url").RESOURCE("#jane").Text("Jane"),
+		},
+		{
+			Expected: `<div></div>`,
+			Actual:   DIV().VOCAB("https://schema.org/").VOCABRemove(""),
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderAll(t *testing.T) {
+	docs := map[string]ElementRenderer{
+		"index.html": P(Text("home")),
+		"about.html": P(Text("about")),
+		"bad.html":   &failingRenderer{},
+	}
+
+	var (
+		mu       sync.Mutex
+		progress = map[string]bool{}
+	)
+	out, errs := RenderAll(docs, 4, WithRenderProgress(func(name string, err error) {
+		mu.Lock()
+		progress[name] = err == nil
+		mu.Unlock()
+	}))
+
+	assert.Equal(t, []byte(`<p>home</p>`), out["index.html"])
+	assert.Equal(t, []byte(`<p>about</p>`), out["about.html"])
+	assert.NotContains(t, out, "bad.html")
+	assert.Error(t, errs["bad.html"])
+	assert.Len(t, errs, 1)
+	assert.Equal(t, map[string]bool{
+		"index.html": true,
+		"about.html": true,
+		"bad.html":   false,
+	}, progress)
+
+	out, errs = RenderAll(map[string]ElementRenderer{"a": P(Text("a"))}, 0)
+	assert.Nil(t, errs)
+	assert.Equal(t, []byte(`<p>a</p>`), out["a"])
+}
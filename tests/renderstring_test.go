@@ -0,0 +1,23 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderString(t *testing.T) {
+	s, err := DIV(H1(Text("Hello"))).CLASS("hero").RenderString()
+	assert.NoError(t, err)
+	assert.Equal(t, `<div class="hero"><h1>Hello</h1></div>`, s)
+}
+
+func TestStringer(t *testing.T) {
+	el := P(Text("fine print")).ID("legal")
+	assert.Equal(t, `<p id="legal">fine print</p>`, fmt.Sprintf("%s", el))
+
+	// Concrete element types pick up Stringer through the embedded *Element.
+	var _ fmt.Stringer = SPAN()
+}
@@ -0,0 +1,24 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestResetAttributes(t *testing.T) {
+	div := DIV(Text("kept")).
+		ID("x").
+		CLASS("a", "b").
+		TABINDEX(3).
+		DRAGGABLE(DivDraggable_true).
+		STYLE("color", "red")
+	div.ResetAttributes()
+
+	run(t, []result{
+		{
+			Expected: `<div>kept</div>`,
+			Actual:   div,
+		},
+	})
+}
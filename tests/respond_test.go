@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/respond"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespond(t *testing.T) {
+	resp := respond.Response{
+		Page:     HTML(BODY(DIV(Text("7 unread")).ID("inbox"))),
+		Fragment: DIV(Text("7 unread")).ID("inbox"),
+		JSON:     map[string]int{"unread": 7},
+	}
+
+	// Plain browser navigation gets the page.
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/inbox", nil)
+	r.Header.Set("Accept", "text/html,application/xhtml+xml")
+	assert.NoError(t, respond.Write(rec, r, resp))
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `<html><body><div id="inbox">7 unread</div></body></html>`, rec.Body.String())
+
+	// A datastar action gets the fragment as an SSE patch.
+	rec = httptest.NewRecorder()
+	r = httptest.NewRequest("GET", "/inbox", nil)
+	r.Header.Set("Datastar-Request", "true")
+	assert.NoError(t, respond.Write(rec, r, resp))
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "event: datastar-patch-elements")
+	assert.Contains(t, rec.Body.String(), `data: elements <div id="inbox">7 unread</div>`)
+
+	// An API client gets the JSON representation.
+	rec = httptest.NewRecorder()
+	r = httptest.NewRequest("GET", "/inbox", nil)
+	r.Header.Set("Accept", "application/json")
+	assert.NoError(t, respond.Write(rec, r, resp))
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"unread": 7}`, rec.Body.String())
+}
+
+func TestNegotiate(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	assert.Equal(t, respond.KindPage, respond.Negotiate(r))
+
+	r.Header.Set("Accept", "text/event-stream")
+	assert.Equal(t, respond.KindPatch, respond.Negotiate(r))
+
+	// JSON only wins when the client does not also accept HTML.
+	r.Header.Set("Accept", "text/html,application/json")
+	assert.Equal(t, respond.KindPage, respond.Negotiate(r))
+}
+
+func TestRespondPlaintext(t *testing.T) {
+	resp := respond.Response{
+		Page: HTML(BODY(
+			H1(Text("Status")),
+			P(Text("All systems operational.")),
+		)),
+	}
+
+	// curl sends Accept: */* so ?format=txt opts into plain text.
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/status?format=txt", nil)
+	r.Header.Set("Accept", "*/*")
+	assert.NoError(t, respond.Write(rec, r, resp))
+	assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "STATUS\n\nAll systems operational.\n", rec.Body.String())
+
+	// An explicit text/plain Accept works without the query parameter.
+	rec = httptest.NewRecorder()
+	r = httptest.NewRequest("GET", "/status", nil)
+	r.Header.Set("Accept", "text/plain")
+	assert.NoError(t, respond.Write(rec, r, resp))
+	assert.Contains(t, rec.Body.String(), "All systems operational.")
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/head"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRobots(t *testing.T) {
+	policy := head.Robots{
+		NoIndex:         true,
+		NoFollow:        true,
+		MaxImagePreview: "none",
+		MaxSnippet:      50,
+	}
+
+	var sb strings.Builder
+	assert.NoError(t, policy.Meta().Render(&sb))
+	assert.Equal(t,
+		`<meta content="noindex, nofollow, max-image-preview:none, max-snippet:50" name="robots" >`,
+		sb.String())
+
+	rec := httptest.NewRecorder()
+	policy.Header(rec)
+	assert.Equal(t, "noindex, nofollow, max-image-preview:none, max-snippet:50",
+		rec.Header().Get("X-Robots-Tag"))
+}
+
+func TestRobotsZeroPolicy(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, head.Robots{}.Meta().Render(&sb))
+	assert.Empty(t, sb.String())
+
+	rec := httptest.NewRecorder()
+	head.Robots{}.Header(rec)
+	assert.Empty(t, rec.Header().Get("X-Robots-Tag"))
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/linkcheck"
+	"github.com/delaneyj/gostar/routes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutes(t *testing.T) {
+	table := routes.New().
+		Add("home", "/").
+		Add("user.orders", "/users/:id/orders").
+		Add("post", "/posts/:slug")
+
+	assert.Equal(t, "/", table.URL("home"))
+	assert.Equal(t, "/users/42/orders", table.URL("user.orders", 42))
+	assert.Equal(t, "/posts/hello%20world", table.URL("post", "hello world"))
+
+	assert.Panics(t, func() { table.URL("nope") })
+	assert.Panics(t, func() { table.URL("user.orders") })
+	assert.Panics(t, func() { table.URL("home", 1) })
+	assert.Panics(t, func() { routes.New().Add("a", "/a").Add("a", "/b") })
+
+	assert.True(t, table.Known("/users/7/orders"))
+	assert.True(t, table.Known("/"))
+	assert.False(t, table.Known("/users//orders"))
+	assert.False(t, table.Known("/users/7"))
+}
+
+func TestRoutesWithLinkcheck(t *testing.T) {
+	table := routes.New().Add("user", "/users/:id")
+
+	tree := NAV(
+		A(Text("u")).HREF(table.URL("user", 7)),
+		A(Text("bad")).HREF("/admin"),
+	)
+	broken := linkcheck.Check(tree, table.Known)
+	assert.Equal(t, []linkcheck.Broken{{URL: "/admin", Reason: "no matching route"}}, broken)
+}
@@ -0,0 +1,30 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestPageScaffold(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<a class="gostar-skip-link" href="#main-content">Skip to content</a>` +
+				`<nav aria-label="Primary"><a href="/">Home</a></nav>` +
+				`<main id="main-content" tabindex="-1"><h1>Welcome</h1></main>` +
+				`<aside aria-label="Related"><p>links</p></aside>`,
+			Actual: components.PageScaffold(
+				[]ElementRenderer{H1().Text("Welcome")},
+				components.WithScaffoldNav("Primary", A().HREF("/").Text("Home")),
+				components.WithScaffoldAside("Related", P().Text("links")),
+			),
+		},
+		{
+			Expected: `<a class="gostar-skip-link" href="#main-content">Zum Inhalt springen</a>` +
+				`<main id="main-content" tabindex="-1"></main>`,
+			Actual: components.PageScaffold(nil,
+				components.WithSkipLabel("Zum Inhalt springen")),
+		},
+	})
+}
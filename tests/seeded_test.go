@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+type randomIDRenderer struct{}
+
+func (r *randomIDRenderer) Render(w io.Writer) error {
+	_, err := io.WriteString(w, RandomID(w, "el-"))
+	return err
+}
+
+func TestSeeded(t *testing.T) {
+	render := func(seed int64) string {
+		var sb strings.Builder
+		assert.NoError(t, Seeded(seed, DIV(&randomIDRenderer{}, &randomIDRenderer{})).Render(&sb))
+		return sb.String()
+	}
+
+	first := render(42)
+	assert.Equal(t, first, render(42), "same seed must render byte-identical output")
+	assert.NotEqual(t, first, render(43))
+	assert.Regexp(t, `^<div>el-[0-9a-f]{8}el-[0-9a-f]{8}</div>$`, first)
+
+	// Outside a Seeded subtree RandomID still produces well-formed ids.
+	var sb strings.Builder
+	assert.NoError(t, (&randomIDRenderer{}).Render(&sb))
+	assert.Regexp(t, `^el-[0-9a-f]{8}$`, sb.String())
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/forms"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignalName(t *testing.T) {
+	assert.Equal(t, "favoriteColors", forms.SignalName("favorite_colors"))
+	assert.Equal(t, "favoriteColors", forms.SignalName("favorite-colors"))
+	assert.Equal(t, "favoriteColors", forms.SignalName("FavoriteColors"))
+	assert.Equal(t, "color", forms.SignalName("color"))
+}
+
+func TestBoundSelects(t *testing.T) {
+	colors := []forms.Choice{
+		{Value: "red", Label: "Red"},
+		{Value: "green", Label: "Green"},
+		{Value: "blue"},
+	}
+
+	run(t, []result{
+		{
+			Expected: `<select data-bind:color name="color">` +
+				`<option value="red">Red</option>` +
+				`<option selected value="green">Green</option>` +
+				`<option value="blue">blue</option>` +
+				`</select>`,
+			Actual: forms.Select("color", colors, "green"),
+		},
+		{
+			Expected: `<select data-bind:favoriteColors multiple name="favorite_colors">` +
+				`<option selected value="red">Red</option>` +
+				`<option value="green">Green</option>` +
+				`<option selected value="blue">blue</option>` +
+				`</select>`,
+			Actual: forms.MultiSelect("favorite_colors", colors, "red", "blue"),
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/delaneyj/gostar/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortcut(t *testing.T) {
+	assert.Equal(t,
+		"if ((evt.ctrlKey || evt.metaKey) && evt.key.toLowerCase() === 'k') { evt.preventDefault(); $open = true }",
+		expr.Shortcut("mod+k", "$open = true"))
+	assert.Equal(t,
+		"if (evt.shiftKey && evt.altKey && evt.key.toLowerCase() === 'p') { evt.preventDefault(); @get('/palette') }",
+		expr.Shortcut("shift+alt+P", "@get('/palette')"))
+
+	run(t, []result{
+		{
+			Expected: `<div aria-hidden="true" ` +
+				`data-on:keydown__window="if (evt.ctrlKey && evt.key.toLowerCase() === 'k') { evt.preventDefault(); $open = true }" ` +
+				`style="display:none"></div>`,
+			Actual: components.Shortcut("ctrl+k", "$open = true"),
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/dev"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintSignals(t *testing.T) {
+	page := DIV(
+		DIV(
+			BUTTON(Text("+1")).DATASTAR_ON("click", "$count = $count + 1"),
+			SPAN().DATASTAR_TEXT("$count"),
+			// Typo: the signal is $userName.
+			P().DATASTAR_SHOW("$userNmae != ''"),
+		),
+	).DATASTAR_SIGNALS("count", "0").DATASTAR_SIGNALS("userName", "''")
+
+	warnings := dev.LintSignals(page)
+	assert.Equal(t, []string{
+		`$userNmae referenced in <p data-show> but never declared`,
+	}, warnings)
+}
+
+func TestLintSignalsObjectAndBind(t *testing.T) {
+	form := FORM(
+		INPUT().DATASTAR_BIND("email", ""),
+		SPAN().DATASTAR_TEXT("$user.name + ' <' + $email + '>'"),
+		DIV().DATASTAR_SHOW("$missing"),
+	).DATASTAR_SIGNALS("", "{user: {name: ''}}")
+
+	warnings := dev.LintSignals(form)
+	assert.Equal(t, []string{
+		`$missing referenced in <div data-show> but never declared`,
+	}, warnings)
+}
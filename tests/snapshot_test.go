@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/valyala/bytebufferpool"
+)
+
+var update = flag.Bool("update", false, "rewrite snapshot goldens under testdata/")
+
+const (
+	colorRed   = "\033[31m"
+	colorGreen = "\033[32m"
+	colorReset = "\033[0m"
+)
+
+// Snapshot renders el and compares it against testdata/<name>.golden.html.
+// Run `go test ./tests/ -update` to (re)write the goldens; on mismatch the
+// failure shows a per-tag colored diff.
+func Snapshot(t *testing.T, name string, el ElementRenderer) {
+	t.Helper()
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	if err := el.Render(buf); err != nil {
+		t.Fatalf("snapshot %s: render failed: %v", name, err)
+	}
+	actual := buf.String()
+
+	path := filepath.Join("testdata", name+".golden.html")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("snapshot %s: %v (run with -update to create it)", name, err)
+	}
+	if string(golden) == actual {
+		return
+	}
+	t.Errorf("snapshot %s differs from %s (run with -update to accept):\n%s",
+		name, path, htmlDiff(string(golden), actual))
+}
+
+// htmlDiff splits both documents at tag boundaries and prints a minimal
+// line diff, colored when the output is a terminal.
+func htmlDiff(want, got string) string {
+	wantLines := splitTags(want)
+	gotLines := splitTags(got)
+
+	var b strings.Builder
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w == g {
+			fmt.Fprintf(&b, "  %s\n", w)
+			continue
+		}
+		if w != "" {
+			fmt.Fprintf(&b, "%s- %s%s\n", colorRed, w, colorReset)
+		}
+		if g != "" {
+			fmt.Fprintf(&b, "%s+ %s%s\n", colorGreen, g, colorReset)
+		}
+	}
+	return b.String()
+}
+
+func splitTags(s string) []string {
+	s = strings.ReplaceAll(s, "><", ">\n<")
+	return strings.Split(s, "\n")
+}
+
+func TestSnapshot(t *testing.T) {
+	Snapshot(t, "snapshot-card", DIV(
+		H2(Text("Card")),
+		P(Text("Snapshot me")),
+	).CLASS("card"))
+}
+
+func TestHTMLDiff(t *testing.T) {
+	diff := htmlDiff(`<div><p>old</p></div>`, `<div><p>new</p></div>`)
+	if !strings.Contains(diff, "- <p>old</p>") || !strings.Contains(diff, "+ <p>new</p>") {
+		t.Fatalf("unexpected diff output:\n%s", diff)
+	}
+}
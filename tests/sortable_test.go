@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestSortableList(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<ul id="queue">` +
+				`<li data-on:dragover="evt.preventDefault()" data-on:dragstart="$dragging = 0" ` +
+				`data-on:drop="@post('/queue/reorder?from=' + $dragging + '&to=0')" draggable="true">first</li>` +
+				`<li data-on:dragover="evt.preventDefault()" data-on:dragstart="$dragging = 1" ` +
+				`data-on:drop="@post('/queue/reorder?from=' + $dragging + '&to=1')" draggable="true">second</li>` +
+				`</ul>`,
+			Actual: components.SortableList("queue", "/queue/reorder", []ElementRenderer{
+				Text("first"),
+				Text("second"),
+			}),
+		},
+	})
+}
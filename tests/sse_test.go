@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSEGeneratorWireFormat(t *testing.T) {
+	sb := &strings.Builder{}
+	gen := sse.NewWriter(sb)
+
+	err := gen.PatchElements(DIV().ID("count").Text("1"), sse.WithMode("inner"))
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"event: datastar-patch-elements\ndata: mode inner\ndata: elements <div id=\"count\">1</div>\n\n",
+		sb.String())
+
+	sb.Reset()
+	err = gen.PatchSignals(`{"count": 1}`)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"event: datastar-patch-signals\ndata: signals {\"count\": 1}\n\n",
+		sb.String())
+}
+
+func TestSSERecorder(t *testing.T) {
+	rec := sse.NewRecorder()
+
+	assert.NoError(t, rec.PatchElements(SPAN().ID("msg").Text("hi")))
+	assert.NoError(t, rec.PatchSignals(`{"open": true}`))
+
+	assert.Len(t, rec.Events(), 2)
+	assert.Equal(t, []string{`<span id="msg">hi</span>`}, rec.PatchedElements())
+	assert.Equal(t, []string{`{"open": true}`}, rec.PatchedSignals())
+
+	rec.AssertPatchedElements(t, `id="msg"`)
+	rec.AssertPatchedSignals(t, `"open"`)
+}
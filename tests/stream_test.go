@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/sse"
+	"github.com/delaneyj/gostar/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamScripts(t *testing.T) {
+	s := stream.New()
+	page := DIV(
+		H1(Text("Dashboard")),
+		s.Placeholder("revenue", P(Text("Loading…")), func() (ElementRenderer, error) {
+			time.Sleep(20 * time.Millisecond) // the slow slot finishes last
+			return P(Text("$1,234")), nil
+		}),
+		s.Placeholder("orders", P(Text("Loading…")), func() (ElementRenderer, error) {
+			return P(Text("56 orders")), nil
+		}),
+	)
+
+	var sb strings.Builder
+	assert.NoError(t, page.Render(&sb))
+	shell := sb.String()
+	assert.Contains(t, shell, `<div id="revenue"><p>Loading…</p></div>`)
+	assert.Contains(t, shell, `<div id="orders"><p>Loading…</p></div>`)
+
+	assert.NoError(t, s.StreamScripts(&sb))
+	out := sb.String()
+	assert.Contains(t, out, `<template id="gostar-slot-orders"><p>56 orders</p></template>`)
+	assert.Contains(t, out, `<template id="gostar-slot-revenue"><p>$1,234</p></template>`)
+	assert.Contains(t, out, `document.getElementById('revenue').replaceWith`)
+	// Slots arrive in completion order, not document order.
+	assert.Less(t, strings.Index(out, "gostar-slot-orders"), strings.Index(out, "gostar-slot-revenue"))
+}
+
+func TestStreamPatches(t *testing.T) {
+	s := stream.New()
+	var sb strings.Builder
+	assert.NoError(t, s.Placeholder("cart", nil, func() (ElementRenderer, error) {
+		return SPAN(Text("3 items")), nil
+	}).Render(&sb))
+	assert.Equal(t, `<div id="cart"></div>`, sb.String())
+
+	var events strings.Builder
+	assert.NoError(t, s.StreamPatches(sse.NewWriter(&events)))
+	assert.Contains(t, events.String(), "event: datastar-patch-elements")
+	assert.Contains(t, events.String(), `data: elements <div id="cart"><span>3 items</span></div>`)
+}
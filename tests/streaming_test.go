@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+// flushRecorder notes where in the output each flush happened.
+type flushRecorder struct {
+	strings.Builder
+	flushedAt []int
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushedAt = append(f.flushedAt, f.Len())
+}
+
+func TestFlushMarker(t *testing.T) {
+	page := HTML(
+		HEAD(TITLE(Text("Streaming"))),
+		Flush(),
+		BODY(H1(Text("Hello"))),
+	)
+
+	rec := &flushRecorder{}
+	assert.NoError(t, page.Render(rec))
+	assert.Equal(t, "<html><head><title>Streaming</title></head><body><h1>Hello</h1></body></html>", rec.String())
+	// The flush fired right after the head closed.
+	assert.Equal(t, []int{len("<html><head><title>Streaming</title></head>")}, rec.flushedAt)
+
+	// On a writer without Flush the marker renders nothing.
+	var sb strings.Builder
+	assert.NoError(t, page.Render(&sb))
+	assert.Equal(t, rec.String(), sb.String())
+}
+
+func TestRenderStreamed(t *testing.T) {
+	items := make([]ElementRenderer, 10)
+	for i := range items {
+		items[i] = LI(Text("row with some width to it"))
+	}
+	list := UL(items...)
+
+	rec := &flushRecorder{}
+	assert.NoError(t, RenderStreamed(rec, list, 64))
+
+	var plain strings.Builder
+	assert.NoError(t, list.Render(&plain))
+	assert.Equal(t, plain.String(), rec.String(), "streaming does not change the output")
+
+	assert.Greater(t, len(rec.flushedAt), 1, "flushes periodically through the render")
+	assert.Equal(t, rec.Len(), rec.flushedAt[len(rec.flushedAt)-1], "trailing bytes are flushed")
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestTabs(t *testing.T) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	tabs := components.Tabs("settings", []components.Tab{
+		{Label: "Profile", Panel: P(Text("profile"))},
+		{Label: "Billing", LazyURL: "/settings/billing"},
+	})
+	assert.NoError(t, tabs.Render(buf))
+	html := buf.String()
+
+	assert.Contains(t, html, `role="tablist"`)
+	assert.Contains(t, html, `data-signals:settingsTab="0"`)
+	assert.Contains(t, html, `if (evt.key === 'ArrowRight') $settingsTab = ($settingsTab + 1) % 2`)
+	assert.Contains(t, html, `else if (evt.key === 'ArrowLeft') $settingsTab = ($settingsTab + 1) % 2`)
+	assert.Contains(t, html, `aria-controls="settings-panel-0"`)
+	assert.Contains(t, html, `data-attr:aria-selected="$settingsTab === 0"`)
+	assert.Contains(t, html, `data-on:click="$settingsTab = 0"`)
+	assert.Contains(t, html,
+		`data-on:click="$settingsTab = 1; if (!$settingsLoaded1) { $settingsLoaded1 = true; @get('/settings/billing') }"`)
+	assert.Contains(t, html,
+		`<div aria-labelledby="settings-tab-0" data-show="$settingsTab === 0" id="settings-panel-0" role="tabpanel"><p>profile</p></div>`)
+	assert.Contains(t, html,
+		`<div aria-labelledby="settings-tab-1" data-show="$settingsTab === 1" id="settings-panel-1" role="tabpanel"></div>`)
+}
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/dev"
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestInjectTestIDs(t *testing.T) {
+	build := func() ElementRenderer {
+		return DIV().ID("app").Children(
+			BUTTON().Text("a"),
+			BUTTON().Text("b"),
+		)
+	}
+
+	dev.SetEnabled(false)
+	run(t, []result{
+		{
+			Expected: `<div id="app"><button>a</button><button>b</button></div>`,
+			Actual:   dev.InjectTestIDs(build()),
+		},
+	})
+
+	dev.SetEnabled(true)
+	defer dev.SetEnabled(false)
+	run(t, []result{
+		{
+			Expected: `<div data-testid="app" id="app"><button data-testid="button-0">a</button><button data-testid="button-1">b</button></div>`,
+			Actual:   dev.InjectTestIDs(build()),
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/head"
+)
+
+func TestThemeHelpers(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<meta content="light dark" name="color-scheme" >`,
+			Actual:   head.ColorScheme("light", "dark"),
+		},
+		{
+			Expected: `<meta content="#ffffff" media="(prefers-color-scheme: light)" name="theme-color" >` +
+				`<meta content="#111111" media="(prefers-color-scheme: dark)" name="theme-color" >`,
+			Actual: head.ThemeColor("#ffffff", "#111111"),
+		},
+		{
+			Expected: `<button aria-label="Toggle color theme" ` +
+				`data-on:click="$theme = $theme === 'dark' ? 'light' : 'dark'; ` +
+				`localStorage.setItem('theme', $theme); ` +
+				`document.documentElement.dataset.theme = $theme" type="button">🌓</button>`,
+			Actual: components.ThemeToggle(Text("🌓")),
+		},
+		{
+			Expected: `<script>document.documentElement.dataset.theme = ` +
+				`localStorage.getItem('theme') || ` +
+				`(matchMedia('(prefers-color-scheme: dark)').matches ? 'dark' : 'light');</script>`,
+			Actual: components.ThemeInit(),
+		},
+	})
+}
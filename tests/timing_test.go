@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/timing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimer(t *testing.T) {
+	timer := timing.NewTimer()
+
+	var sb strings.Builder
+	assert.NoError(t, timer.Render("head", &sb, HEAD(TITLE(Text("x")))))
+	assert.NoError(t, timer.Render("body", &sb, BODY(P(Text("y")))))
+	stop := timer.Phase("query")
+	stop()
+
+	value := timer.Value()
+	assert.Contains(t, value, "head;dur=")
+	assert.Contains(t, value, "body;dur=")
+	assert.Contains(t, value, "query;dur=")
+	assert.Equal(t, `<head><title>x</title></head><body><p>y</p></body>`, sb.String())
+
+	rec := httptest.NewRecorder()
+	timer.WriteHeader(rec)
+	assert.Equal(t, value, rec.Header().Get(timing.ServerTimingHeader))
+
+	rec = httptest.NewRecorder()
+	timing.DeclareTrailer(rec)
+	assert.Equal(t, timing.ServerTimingHeader, rec.Header().Get("Trailer"))
+
+	empty := timing.NewTimer()
+	rec = httptest.NewRecorder()
+	empty.WriteHeader(rec)
+	assert.Empty(t, rec.Header().Get(timing.ServerTimingHeader))
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/delaneyj/gostar/components"
+	"github.com/delaneyj/gostar/sse"
+)
+
+func TestToasts(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<div aria-live="polite" class="gostar-toasts" id="gostar-toasts"></div>`,
+			Actual:   components.Toasts(),
+		},
+		{
+			Expected: `<div class="gostar-toast gostar-toast-success" data-on-interval__duration.5s="el.remove()" role="status">Saved!</div>`,
+			Actual:   components.Toast(components.ToastSuccess, "Saved!"),
+		},
+		{
+			Expected: `<div class="gostar-toast gostar-toast-error" role="status">Disk full &lt;.&lt;</div>`,
+			Actual:   components.Toast(components.ToastError, "Disk full <.<", components.WithToastDuration(0)),
+		},
+		{
+			Expected: `<div class="gostar-toast gostar-toast-info" data-on-interval__duration.1500ms="el.remove()" role="status">hi</div>`,
+			Actual:   components.Toast(components.ToastInfo, "hi", components.WithToastDuration(1500*time.Millisecond)),
+		},
+	})
+}
+
+func TestPushToast(t *testing.T) {
+	rec := sse.NewRecorder()
+	if err := components.PushToast(rec.Generator, components.ToastWarning, "Session expiring"); err != nil {
+		t.Fatal(err)
+	}
+	rec.AssertPatchedElements(t, "gostar-toast-warning")
+	rec.AssertPatchedElements(t, "Session expiring")
+
+	evts := rec.EventsOfType(sse.EventTypePatchElements)
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 patch event, got %d", len(evts))
+	}
+	if evts[0].Data[0] != "selector #gostar-toasts" || evts[0].Data[1] != "mode append" {
+		t.Fatalf("unexpected patch options: %v", evts[0].Data[:2])
+	}
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestTableOfContents(t *testing.T) {
+	page := ARTICLE(
+		H1().Text("Intro"),
+		H2().Text("Getting Started"),
+		H3().Text("Install"),
+		H2().ID("custom").Text("Usage"),
+	)
+
+	toc := components.TableOfContents(page)
+
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+	assert.NoError(t, toc.Render(buf))
+	assert.Equal(t,
+		`<nav aria-label="Table of contents"><ol><li><a href="#intro">Intro</a><ol><li><a href="#getting-started">Getting Started</a><ol><li><a href="#install">Install</a></li></ol></li><li><a href="#custom">Usage</a></li></ol></li></ol></nav>`,
+		buf.String())
+
+	// Headings without an id were mutated so the anchors resolve.
+	buf.Reset()
+	assert.NoError(t, page.Render(buf))
+	assert.Contains(t, buf.String(), `<h1 id="intro">Intro</h1>`)
+}
@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+)
+
+func TestUnsafeRaw(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<article><p>From the <b>CMS</b></p></article>`,
+			Actual:   ARTICLE(UnsafeRaw(`<p>From the <b>CMS</b></p>`)),
+		},
+		{
+			Expected: `<div>&lt;b&gt;escaped&lt;/b&gt;</div>`,
+			Actual:   DIV(Escaped(`<b>escaped</b>`)),
+		},
+	})
+}
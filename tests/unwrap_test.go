@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/delaneyj/gostar/analytics"
+	"github.com/delaneyj/gostar/auth"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/flags"
+	"github.com/delaneyj/gostar/i18n"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+// contextProbe reads every render context from inside the tree, so nesting
+// wrappers in any order must still resolve all of them.
+type contextProbe struct{}
+
+func (p *contextProbe) Render(w io.Writer) error {
+	id, authed := auth.IdentityOf(w)
+	provider := flags.ProviderOf(w)
+	_, err := fmt.Fprintf(w, "locale=%s subject=%s authed=%t flag=%t consent=%t",
+		i18n.LocaleOf(w), id.Subject, authed,
+		provider != nil && provider.Enabled("beta"), analytics.ConsentOf(w))
+	return err
+}
+
+// randProbe renders a RandomID, deterministic only under Seeded.
+type randProbe struct{}
+
+func (p *randProbe) Render(w io.Writer) error {
+	_, err := io.WriteString(w, RandomID(w, "id-"))
+	return err
+}
+
+func TestNestedWriterWrappers(t *testing.T) {
+	id := auth.Identity{Subject: "u1"}
+	fl := flags.Map{"beta": true}
+	expected := "locale=de subject=u1 authed=true flag=true consent=true"
+
+	// Every wrapper sees the ones installed outside it, whatever the order.
+	trees := []ElementRenderer{
+		i18n.Localize(language.German,
+			auth.WithIdentity(id,
+				flags.WithFlags(fl,
+					analytics.WithConsent(true, &contextProbe{})))),
+		analytics.WithConsent(true,
+			flags.WithFlags(fl,
+				auth.WithIdentity(id,
+					i18n.Localize(language.German, &contextProbe{})))),
+	}
+	for _, tree := range trees {
+		var sb strings.Builder
+		assert.NoError(t, tree.Render(&sb))
+		assert.Equal(t, expected, sb.String())
+	}
+
+	// Seeded stacks like the rest: RandomID stays deterministic under an
+	// outer wrapper.
+	render := func(root ElementRenderer) string {
+		var sb strings.Builder
+		assert.NoError(t, root.Render(&sb))
+		return sb.String()
+	}
+	seeded := render(Seeded(42, i18n.Localize(language.German, &randProbe{})))
+	assert.Equal(t, seeded, render(Seeded(42, i18n.Localize(language.German, &randProbe{}))))
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/forms"
+)
+
+func TestFileUpload(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<input name="avatar" type="file" >`,
+			Actual:   forms.FileInput("avatar"),
+		},
+		{
+			Expected: `<input accept="image/*" multiple name="photos" type="file" >`,
+			Actual: forms.FileInput("photos",
+				forms.WithUploadAccept("image/*"),
+				forms.WithUploadMultiple(),
+			),
+		},
+		{
+			Expected: `<form action="/upload" data-indicator="uploading" ` +
+				`data-on:submit="@post('/upload', {contentType: 'form'})" ` +
+				`enctype="multipart/form-data" method="post">` +
+				`<input name="doc" type="file" ></form>`,
+			Actual: forms.UploadForm("/upload").Children(
+				forms.FileInput("doc"),
+			),
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/delaneyj/gostar/head"
+)
+
+func TestViewTransitions(t *testing.T) {
+	hero := DIV().CLASS("hero")
+	hero.TransitionName("hero")
+
+	run(t, []result{
+		{
+			Expected: `<meta content="same-origin" name="view-transition" >`,
+			Actual:   head.ViewTransitions(),
+		},
+		{
+			Expected: `<div class="hero" style="view-transition-name:hero"></div>`,
+			Actual:   hero,
+		},
+		{
+			Expected: `<div><button data-on:click__viewtransition="@get('/next')">Next</button>` +
+				`<button data-on:click__once__viewtransition="$x++">Once</button></div>`,
+			Actual: components.ViewTransitionDefaults(DIV(
+				BUTTON().DATASTAR_ON("click", "@get('/next')").Text("Next"),
+				BUTTON().DATASTAR_ON("click", "$x++", ButtonOnModOnce(), ButtonOnModViewTransition()).Text("Once"),
+			)),
+		},
+	})
+}
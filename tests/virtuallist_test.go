@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualList(t *testing.T) {
+	run(t, []result{
+		{
+			Expected: `<ul class="gostar-virtual-list" id="feed-list">` +
+				`<li>one</li><li>two</li>` +
+				`<li aria-hidden="true" data-on-intersect__once="@get('/feed?offset=2')" id="feed-sentinel-2"></li>` +
+				`</ul>`,
+			Actual: components.VirtualList("feed", "/feed", 0, 5,
+				[]ElementRenderer{Text("one"), Text("two")}),
+		},
+		{
+			Expected: `<ul class="gostar-virtual-list" id="feed-list"><li>only</li></ul>`,
+			Actual: components.VirtualList("feed", "/feed", 0, 1,
+				[]ElementRenderer{Text("only")}),
+		},
+		{
+			Expected: `<li>three</li>` +
+				`<li aria-hidden="true" data-on-intersect__once="@get('/feed?offset=3')" id="feed-sentinel-3"></li>`,
+			Actual: components.VirtualRows("feed", "/feed", 2, 5,
+				[]ElementRenderer{Text("three")}),
+		},
+	})
+}
+
+func TestNextWindow(t *testing.T) {
+	start, end, ok := components.NextWindow(0, 50, 120)
+	assert.True(t, ok)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 50, end)
+
+	start, end, ok = components.NextWindow(100, 50, 120)
+	assert.True(t, ok)
+	assert.Equal(t, 100, start)
+	assert.Equal(t, 120, end)
+
+	_, _, ok = components.NextWindow(120, 50, 120)
+	assert.False(t, ok)
+
+	start, _, ok = components.NextWindow(-5, 10, 120)
+	assert.True(t, ok)
+	assert.Equal(t, 0, start)
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/delaneyj/gostar/components"
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/bytebufferpool"
+)
+
+func TestWizard(t *testing.T) {
+	buf := bytebufferpool.Get()
+	defer bytebufferpool.Put(buf)
+
+	wiz := components.Wizard("signup", []components.WizardStep{
+		{Label: "Account", Content: P(Text("account")), Valid: "$email !== ''"},
+		{Label: "Confirm", Content: P(Text("confirm"))},
+	},
+		components.WithWizardStepURL("/signup/step"),
+		components.WithWizardSubmitURL("/signup"),
+	)
+	assert.NoError(t, wiz.Render(buf))
+	html := buf.String()
+
+	assert.Contains(t, html, `data-signals:signupStep="0"`)
+	assert.Contains(t, html, `<li data-attr:aria-current="$signupStep === 0 ? 'step' : false">Account</li>`)
+	assert.Contains(t, html, `id="signup-step-0"`)
+	assert.Contains(t, html, `data-show="$signupStep === 0"`)
+	assert.Contains(t, html,
+		`<button data-attr:disabled="!($email !== '')" data-on:click="$signupStep = 1; @post('/signup/step')" type="button">Next</button>`)
+	assert.Contains(t, html,
+		`<button data-on:click="$signupStep = 0" type="button">Back</button>`)
+	assert.Contains(t, html,
+		`<button data-on:click="@post('/signup')" type="button">Finish</button>`)
+}
@@ -0,0 +1,23 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	. "github.com/delaneyj/gostar/elements"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTo(t *testing.T) {
+	// Concrete element types satisfy io.WriterTo through the embedded
+	// *Element.
+	var _ io.WriterTo = DIV()
+
+	el := UL(LI(Text("one")), LI(Text("two"))).CLASS("steps")
+	var buf bytes.Buffer
+	n, err := el.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `<ul class="steps"><li>one</li><li>two</li></ul>`, buf.String())
+	assert.Equal(t, int64(buf.Len()), n)
+}
@@ -0,0 +1,86 @@
+// Package timing attributes backend render time for real-user monitoring.
+// A Timer wraps render phases and emits the result as a Server-Timing
+// header, or as a trailer when the body has already started streaming.
+package timing
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/delaneyj/gostar/elements"
+)
+
+// ServerTimingHeader is the header (and trailer) name written by a Timer.
+const ServerTimingHeader = "Server-Timing"
+
+type phase struct {
+	name string
+	dur  time.Duration
+}
+
+// Timer collects named phase durations. Zero value is not usable; call
+// NewTimer. Timers are not safe for concurrent phases.
+type Timer struct {
+	now    func() time.Time
+	phases []phase
+}
+
+// NewTimer returns an empty Timer.
+func NewTimer() *Timer {
+	return &Timer{now: time.Now}
+}
+
+// Phase starts timing name and returns the stop function:
+//
+//	stop := timer.Phase("query")
+//	rows := load(db)
+//	stop()
+func (t *Timer) Phase(name string) func() {
+	start := t.now()
+	return func() {
+		t.phases = append(t.phases, phase{name: name, dur: t.now().Sub(start)})
+	}
+}
+
+// Render renders root to w as a timed phase, so head, body and fragment
+// renders each show up separately in the monitoring waterfall.
+func (t *Timer) Render(name string, w io.Writer, root elements.ElementRenderer) error {
+	defer t.Phase(name)()
+	return root.Render(w)
+}
+
+// Value formats the recorded phases as a Server-Timing header value,
+// e.g. "head;dur=1.2, body;dur=30.5".
+func (t *Timer) Value() string {
+	parts := make([]string, 0, len(t.phases))
+	for _, p := range t.phases {
+		parts = append(parts, fmt.Sprintf("%s;dur=%.1f", p.name, float64(p.dur)/float64(time.Millisecond)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// WriteHeader sets the Server-Timing header; call it before the first body
+// byte. For streamed pages where phases finish after the status line, use
+// DeclareTrailer/WriteTrailer instead.
+func (t *Timer) WriteHeader(w http.ResponseWriter) {
+	if v := t.Value(); v != "" {
+		w.Header().Set(ServerTimingHeader, v)
+	}
+}
+
+// DeclareTrailer announces the Server-Timing trailer; call it before
+// WriteHeader or the first write.
+func DeclareTrailer(w http.ResponseWriter) {
+	w.Header().Add("Trailer", ServerTimingHeader)
+}
+
+// WriteTrailer sets the Server-Timing trailer value after the body is
+// written; pair it with DeclareTrailer.
+func (t *Timer) WriteTrailer(w http.ResponseWriter) {
+	if v := t.Value(); v != "" {
+		w.Header().Set(http.TrailerPrefix+ServerTimingHeader, v)
+	}
+}